@@ -0,0 +1,109 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+const (
+	StageParse   = "parse"
+	StagePartOne = "part1"
+	StagePartTwo = "part2"
+)
+
+// Case benchmarks one stage of one day against a fixed input.
+type Case struct {
+	Day   int
+	Stage string
+	Run   func(b *testing.B)
+}
+
+func (c Case) Name() string {
+	return fmt.Sprintf("day%02d/%s", c.Day, c.Stage)
+}
+
+// Cases builds the parse, part one and part two benchmarks for each day,
+// reading inputs from the day directories under root.
+func Cases(root string, days []int, example bool) ([]Case, error) {
+	var cases []Case
+	for _, day := range days {
+		input, err := solver.ReadInput(filepath.Join(root, solver.InputPath(day, example)))
+		if err != nil {
+			return nil, err
+		}
+
+		dayCases, err := InputCases(day, input, solver.Options{Example: example})
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, dayCases...)
+	}
+	return cases, nil
+}
+
+// Generated builds the benchmarks for each day on an input generated from
+// seed. A size of zero uses each generator's default size.
+func Generated(days []int, seed uint64, size int) ([]Case, error) {
+	var cases []Case
+	for _, day := range days {
+		in, err := gen.New(day, seed, size)
+		if err != nil {
+			return nil, err
+		}
+
+		dayCases, err := InputCases(day, in.Data, in.Options())
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, dayCases...)
+	}
+	return cases, nil
+}
+
+// InputCases builds the parse, part one and part two benchmarks of a day for
+// one input.
+func InputCases(day int, input []byte, opts solver.Options) ([]Case, error) {
+	if _, err := solver.New(day, opts); err != nil {
+		return nil, err
+	}
+
+	return []Case{
+		{day, StageParse, parseBench(day, input, opts)},
+		{day, StagePartOne, partBench(day, input, opts, solver.Solver.PartOne)},
+		{day, StagePartTwo, partBench(day, input, opts, solver.Solver.PartTwo)},
+	}, nil
+}
+
+func parseBench(day int, input []byte, opts solver.Options) func(b *testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s, _ := solver.New(day, opts)
+			if err := s.Parse(bytes.NewReader(input)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func partBench(day int, input []byte, opts solver.Options, part func(solver.Solver, context.Context) any) func(b *testing.B) {
+	return func(b *testing.B) {
+		s, _ := solver.New(day, opts)
+		if err := s.Parse(bytes.NewReader(input)); err != nil {
+			b.Fatal(err)
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		ctx := context.Background()
+		for i := 0; i < b.N; i++ {
+			part(s, ctx)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+)
+
+type Result struct {
+	Day         int    `json:"day"`
+	Stage       string `json:"stage"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	BytesPerOp  int64  `json:"bytes_per_op"`
+	AllocsPerOp int64  `json:"allocs_per_op"`
+}
+
+func (r Result) key() string {
+	return fmt.Sprintf("day%02d/%s", r.Day, r.Stage)
+}
+
+// Measure runs a case through testing.Benchmark, outside of go test.
+func Measure(c Case) Result {
+	br := testing.Benchmark(c.Run)
+	return Result{
+		Day:         c.Day,
+		Stage:       c.Stage,
+		NsPerOp:     br.NsPerOp(),
+		BytesPerOp:  br.AllocedBytesPerOp(),
+		AllocsPerOp: br.AllocsPerOp(),
+	}
+}
+
+type Report []Result
+
+func LoadReport(path string) (Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	return r, nil
+}
+
+func (r Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Regression is a metric that grew by more than the allowed threshold.
+type Regression struct {
+	Name     string
+	Metric   string
+	Baseline int64
+	Current  int64
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%s %s: %d -> %d (%s)", r.Name, r.Metric, r.Baseline, r.Current, change(r.Baseline, r.Current))
+}
+
+// Compare flags every metric that grew by more than threshold, a fraction of
+// the baseline value. Results without a baseline entry are never flagged.
+func Compare(current, baseline Report, threshold float64) []Regression {
+	base := baseline.index()
+
+	var regressions []Regression
+	for _, res := range current {
+		old, ok := base[res.key()]
+		if !ok {
+			continue
+		}
+
+		metrics := []struct {
+			name      string
+			base, cur int64
+		}{
+			{"ns/op", old.NsPerOp, res.NsPerOp},
+			{"B/op", old.BytesPerOp, res.BytesPerOp},
+			{"allocs/op", old.AllocsPerOp, res.AllocsPerOp},
+		}
+		for _, m := range metrics {
+			if float64(m.cur) > float64(m.base)*(1+threshold) {
+				regressions = append(regressions, Regression{res.key(), m.name, m.base, m.cur})
+			}
+		}
+	}
+	return regressions
+}
+
+// Markdown writes the report as a table. When a baseline is given, the change
+// in ns/op is shown and regressed rows are marked.
+func (r Report) Markdown(w io.Writer, baseline Report, threshold float64) error {
+	base := baseline.index()
+	regressed := make(map[string]bool)
+	for _, reg := range Compare(r, baseline, threshold) {
+		regressed[reg.Name] = true
+	}
+
+	if _, err := fmt.Fprintln(w, "| Day | Stage | ns/op | B/op | allocs/op | vs baseline |"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "|----:|-------|------:|-----:|----------:|-------------|"); err != nil {
+		return err
+	}
+
+	for _, res := range r {
+		delta := "-"
+		if old, ok := base[res.key()]; ok {
+			delta = change(old.NsPerOp, res.NsPerOp)
+			if regressed[res.key()] {
+				delta += " **regressed**"
+			}
+		}
+
+		_, err := fmt.Fprintf(w, "| %02d | %s | %d | %d | %d | %s |\n",
+			res.Day, res.Stage, res.NsPerOp, res.BytesPerOp, res.AllocsPerOp, delta)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r Report) JSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func (r Report) index() map[string]Result {
+	m := make(map[string]Result, len(r))
+	for _, res := range r {
+		m[res.key()] = res
+	}
+	return m
+}
+
+func change(base, cur int64) string {
+	if base == 0 {
+		if cur == 0 {
+			return "+0.0%"
+		}
+		return "n/a"
+	}
+	return fmt.Sprintf("%+.1f%%", (float64(cur)-float64(base))/float64(base)*100)
+}
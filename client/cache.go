@@ -0,0 +1,77 @@
+package client
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const lastRequestFile = "last-request"
+
+// ErrCorruptStamp is returned by LastRequest when the recorded time cannot be
+// read back.
+var ErrCorruptStamp = errors.New("last request time is corrupt")
+
+// Cache keeps downloaded files under a directory outside the repository. The
+// files hold personal puzzle data, so they are only readable by their owner.
+type Cache struct {
+	Dir string
+}
+
+// Load returns a cached file and whether it exists.
+func (c Cache) Load(name string) ([]byte, bool, error) {
+	data, err := os.ReadFile(filepath.Join(c.Dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// Store writes a file to the cache, replacing any previous version
+// atomically so that an interrupted download never leaves a partial file.
+func (c Cache) Store(name string, data []byte) error {
+	path := filepath.Join(c.Dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LastRequest returns when a request was last sent, or the zero time when no
+// request has been recorded. A stamp that cannot be parsed is ErrCorruptStamp.
+func (c Cache) LastRequest() (time.Time, error) {
+	data, ok, err := c.Load(lastRequestFile)
+	if err != nil || !ok {
+		return time.Time{}, err
+	}
+
+	nanos, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return time.Time{}, ErrCorruptStamp
+	}
+	return time.Unix(0, nanos), nil
+}
+
+func (c Cache) SetLastRequest(t time.Time) error {
+	return c.Store(lastRequestFile, []byte(strconv.FormatInt(t.UnixNano(), 10)))
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	Year           = 2024
+
+	// UserAgent identifies the tool to the Advent of Code servers, as their
+	// automation guidelines ask.
+	UserAgent = "github.com/reecepm/aoc-2024 (aoc command line tool)"
+
+	// DefaultInterval is the minimum gap between two requests, shared by every
+	// process using the same cache.
+	DefaultInterval = 5 * time.Second
+
+	SessionEnv = "AOC_SESSION"
+	CacheEnv   = "AOC_CACHE_DIR"
+)
+
+var (
+	ErrNoSession    = errors.New("no session cookie; set " + SessionEnv)
+	ErrUnauthorized = errors.New("session cookie was rejected")
+	ErrNotFound     = errors.New("puzzle is not available yet")
+)
+
+// Client downloads puzzle data for one session, caching everything it fetches
+// and spacing out its requests.
+type Client struct {
+	BaseURL  string
+	Session  string
+	HTTP     *http.Client
+	Cache    Cache
+	Interval time.Duration
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+func New(session, cacheDir string) *Client {
+	return &Client{
+		BaseURL:  DefaultBaseURL,
+		Session:  session,
+		HTTP:     &http.Client{Timeout: 30 * time.Second},
+		Cache:    Cache{Dir: cacheDir},
+		Interval: DefaultInterval,
+		now:      time.Now,
+		sleep:    sleep,
+	}
+}
+
+// FromEnv builds a client from the session cookie in AOC_SESSION, caching
+// under AOC_CACHE_DIR or the user's cache directory.
+func FromEnv() (*Client, error) {
+	session := os.Getenv(SessionEnv)
+	if session == "" {
+		return nil, ErrNoSession
+	}
+
+	dir := os.Getenv(CacheEnv)
+	if dir == "" {
+		base, err := os.UserCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("finding cache directory: %w", err)
+		}
+		dir = filepath.Join(base, "aoc-2024")
+	}
+
+	return New(session, dir), nil
+}
+
+// Input returns the puzzle input for a day, downloading it only when it is
+// not already cached. Set refresh to ignore the cache.
+func (c *Client) Input(ctx context.Context, day int, refresh bool) ([]byte, error) {
+	name := c.userFile(day, "input.txt")
+	if !refresh {
+		if data, ok, err := c.Cache.Load(name); err != nil || ok {
+			return data, err
+		}
+	}
+
+	data, err := c.get(ctx, fmt.Sprintf("/%d/day/%d/input", Year, day))
+	if err != nil {
+		return nil, fmt.Errorf("fetching day %d input: %w", day, err)
+	}
+
+	if err := c.Cache.Store(name, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// userFile names a cached file for this session. Inputs differ between
+// accounts, so each session gets its own directory.
+func (c *Client) userFile(day int, name string) string {
+	sum := sha256.Sum256([]byte(c.Session))
+	return filepath.Join(hex.EncodeToString(sum[:8]), fmt.Sprintf("day-%02d", day), name)
+}
+
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	if err := c.throttle(req.Context()); err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", UserAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return body, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+	case resp.StatusCode == http.StatusBadRequest ||
+		resp.StatusCode == http.StatusUnauthorized ||
+		resp.StatusCode == http.StatusForbidden:
+		return nil, ErrUnauthorized
+	default:
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
+
+// throttle waits until Interval has passed since the last request made by any
+// client sharing the cache, then records this one. A corrupt record counts as
+// a request made just now.
+func (c *Client) throttle(ctx context.Context) error {
+	last, err := c.Cache.LastRequest()
+	if errors.Is(err, ErrCorruptStamp) {
+		// The last request could have been moments ago, so wait in full.
+		last, err = c.now(), nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if wait := last.Add(c.Interval).Sub(c.now()); wait > 0 {
+		if err := c.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+	return c.Cache.SetLastRequest(c.now())
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
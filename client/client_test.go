@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeClock stands in for the wall clock so rate limiting runs instantly.
+type fakeClock struct {
+	now    time.Time
+	waited []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.waited = append(c.waited, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *fakeClock) {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Unix(1733011200, 0)}
+	c := New("secret", t.TempDir())
+	c.BaseURL = srv.URL
+	c.HTTP = srv.Client()
+	c.now = clock.Now
+	c.sleep = clock.Sleep
+	return c, clock
+}
+
+func TestInputSendsSessionAndUserAgent(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2024/day/3/input" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, %v", cookie, err)
+		}
+		if ua := r.Header.Get("User-Agent"); ua != UserAgent {
+			t.Errorf("User-Agent = %q", ua)
+		}
+		w.Write([]byte("mul(2,4)\n"))
+	})
+
+	data, err := c.Input(context.Background(), 3, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "mul(2,4)\n" {
+		t.Errorf("input = %q", data)
+	}
+}
+
+func TestInputIsCached(t *testing.T) {
+	requests := 0
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("input"))
+	})
+
+	for range 3 {
+		if _, err := c.Input(context.Background(), 1, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("server saw %d requests, want 1", requests)
+	}
+
+	if _, err := c.Input(context.Background(), 1, true); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("refresh made %d requests in total, want 2", requests)
+	}
+
+	// Another account must not see the first one's input.
+	other := New("other", c.Cache.Dir)
+	if name, otherName := c.userFile(1, "input.txt"), other.userFile(1, "input.txt"); name == otherName {
+		t.Errorf("sessions share cache file %s", name)
+	}
+}
+
+func TestRequestsAreSpacedOut(t *testing.T) {
+	c, clock := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("input"))
+	})
+
+	for day := 1; day <= 3; day++ {
+		if _, err := c.Input(context.Background(), day, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []time.Duration{DefaultInterval, DefaultInterval}
+	if len(clock.waited) != len(want) {
+		t.Fatalf("waited %v, want %v", clock.waited, want)
+	}
+	for i := range want {
+		if clock.waited[i] != want[i] {
+			t.Errorf("wait %d = %v, want %v", i, clock.waited[i], want[i])
+		}
+	}
+
+	// A new process sharing the cache picks up the last request time.
+	next := New("secret", c.Cache.Dir)
+	next.BaseURL, next.HTTP, next.now, next.sleep = c.BaseURL, c.HTTP, clock.Now, clock.Sleep
+	if _, err := next.Input(context.Background(), 4, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(clock.waited) != 3 {
+		t.Errorf("second client did not wait: %v", clock.waited)
+	}
+}
+
+func TestCorruptStampWaits(t *testing.T) {
+	c, clock := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("input"))
+	})
+	if err := c.Cache.Store(lastRequestFile, []byte("garbage")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Input(context.Background(), 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(clock.waited) != 1 || clock.waited[0] != DefaultInterval {
+		t.Errorf("waited %v, want one wait of %v", clock.waited, DefaultInterval)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusBadRequest, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "nope", tt.status)
+			})
+
+			if _, err := c.Input(context.Background(), 1, false); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if _, ok, _ := c.Cache.Load(c.userFile(1, "input.txt")); ok {
+				t.Error("failed download was cached")
+			}
+		})
+	}
+}
+
+func TestFromEnvNeedsSession(t *testing.T) {
+	t.Setenv(SessionEnv, "")
+	if _, err := FromEnv(); !errors.Is(err, ErrNoSession) {
+		t.Fatalf("err = %v, want ErrNoSession", err)
+	}
+
+	dir := t.TempDir()
+	t.Setenv(SessionEnv, "secret")
+	t.Setenv(CacheEnv, dir)
+	c, err := FromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Cache.Dir != dir {
+		t.Errorf("cache dir = %q", c.Cache.Dir)
+	}
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Verdict string
+
+const (
+	Correct  Verdict = "correct"
+	TooHigh  Verdict = "too-high"
+	TooLow   Verdict = "too-low"
+	Wrong    Verdict = "wrong"
+	Wait     Verdict = "wait"
+	Finished Verdict = "already-solved"
+)
+
+// Outcome is the result of submitting an answer.
+type Outcome struct {
+	Verdict Verdict
+	// Wait is how long the site asks us to hold off before the next attempt.
+	Wait time.Duration
+	// Local is set when the verdict came from earlier submissions and nothing
+	// was sent.
+	Local bool
+}
+
+func (o Outcome) String() string {
+	s := string(o.Verdict)
+	if o.Wait > 0 {
+		s += fmt.Sprintf(" (wait %v)", o.Wait)
+	}
+	if o.Local {
+		s += " [from history]"
+	}
+	return s
+}
+
+// Submission is one answer recorded in a day's history.
+type Submission struct {
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	At      time.Time `json:"at"`
+}
+
+type history struct {
+	Submissions []Submission `json:"submissions"`
+	// NotBefore is when the site will next accept an answer for the day.
+	NotBefore time.Time `json:"not_before,omitempty"`
+}
+
+// Submit posts an answer for one part of a day. Answers already known to be
+// right or wrong, from earlier submissions, are judged locally instead, as is
+// any attempt made before the site's cooldown has passed.
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (Outcome, error) {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return Outcome{}, fmt.Errorf("empty answer")
+	}
+
+	name := c.userFile(day, "submissions.json")
+	h, err := c.loadHistory(name)
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	if v, ok := h.judge(part, answer); ok {
+		return Outcome{Verdict: v, Local: true}, nil
+	}
+	if wait := h.NotBefore.Sub(c.now()); wait > 0 {
+		return Outcome{Verdict: Wait, Wait: wait, Local: true}, nil
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, Year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Outcome{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(req)
+	if err != nil {
+		return Outcome{}, fmt.Errorf("submitting day %d part %d: %w", day, part, err)
+	}
+
+	out, err := parseOutcome(string(body))
+	if err != nil {
+		return Outcome{}, fmt.Errorf("submitting day %d part %d: %w", day, part, err)
+	}
+
+	if out.Wait > 0 {
+		h.NotBefore = c.now().Add(out.Wait)
+	}
+	if out.Verdict != Wait && out.Verdict != Finished {
+		h.Submissions = append(h.Submissions, Submission{part, answer, out.Verdict, c.now()})
+	}
+	return out, c.storeHistory(name, h)
+}
+
+// History returns the recorded submissions for a day, oldest first.
+func (c *Client) History(day int) ([]Submission, error) {
+	h, err := c.loadHistory(c.userFile(day, "submissions.json"))
+	return h.Submissions, err
+}
+
+func (c *Client) loadHistory(name string) (history, error) {
+	var h history
+	data, ok, err := c.Cache.Load(name)
+	if err != nil || !ok {
+		return h, err
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return h, fmt.Errorf("decoding %s: %w", name, err)
+	}
+	return h, nil
+}
+
+func (c *Client) storeHistory(name string, h history) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return c.Cache.Store(name, append(data, '\n'))
+}
+
+// judge decides an answer from earlier submissions when it can: a repeat of
+// a judged answer, anything other than a known correct answer, or a number
+// beyond a bound the site has already given.
+func (h history) judge(part int, answer string) (Verdict, bool) {
+	n, err := strconv.ParseInt(answer, 10, 64)
+	numeric := err == nil
+	for _, s := range h.Submissions {
+		if s.Part != part {
+			continue
+		}
+
+		switch {
+		case s.Answer == answer:
+			return s.Verdict, true
+		case s.Verdict == Correct:
+			return Wrong, true
+		}
+
+		bound, err := strconv.ParseInt(s.Answer, 10, 64)
+		if err != nil || !numeric {
+			continue
+		}
+		if s.Verdict == TooHigh && n >= bound {
+			return TooHigh, true
+		}
+		if s.Verdict == TooLow && n <= bound {
+			return TooLow, true
+		}
+	}
+	return "", false
+}
+
+var (
+	leftToWait   = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	pleaseWait   = regexp.MustCompile(`[Pp]lease wait (one|\d+) minutes? before trying again`)
+	articleBlock = regexp.MustCompile(`(?s)<article>(.*?)</article>`)
+)
+
+// parseOutcome reads the verdict out of the page the site returns after an
+// answer is posted.
+func parseOutcome(page string) (Outcome, error) {
+	text := page
+	if m := articleBlock.FindStringSubmatch(page); m != nil {
+		text = m[1]
+	}
+
+	var out Outcome
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		out.Verdict = Correct
+	case strings.Contains(text, "That's not the right answer"):
+		out.Verdict = Wrong
+		if strings.Contains(text, "your answer is too high") {
+			out.Verdict = TooHigh
+		} else if strings.Contains(text, "your answer is too low") {
+			out.Verdict = TooLow
+		}
+	case strings.Contains(text, "You gave an answer too recently"):
+		out.Verdict = Wait
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		out.Verdict = Finished
+	default:
+		return Outcome{}, fmt.Errorf("unrecognised response: %.200q", text)
+	}
+
+	if m := leftToWait.FindStringSubmatch(text); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		out.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := pleaseWait.FindStringSubmatch(text); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		out.Wait = time.Duration(minutes) * time.Minute
+	}
+	return out, nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeSite judges answers the way the real site does, wrapping each verdict in
+// an article element on an otherwise irrelevant page.
+type fakeSite struct {
+	answers map[string]int
+	posts   []string
+	// recent makes the next post hit the "too recently" page.
+	recent bool
+}
+
+func (s *fakeSite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/2024/day/7/answer" {
+		http.NotFound(w, r)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	level, answer := r.PostForm.Get("level"), r.PostForm.Get("answer")
+	s.posts = append(s.posts, level+":"+answer)
+
+	var msg string
+	switch want, n := s.answers[level], atoi(answer); {
+	case s.recent:
+		s.recent = false
+		msg = "You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 30s left to wait."
+	case n == want:
+		msg = "That's the right answer!  You are one gold star closer to finding the Chief Historian."
+	case n > want:
+		msg = "That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again."
+	default:
+		msg = "That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again."
+	}
+	fmt.Fprintf(w, "<html><body><main><article><p>%s</p></article></main></body></html>", msg)
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func TestSubmit(t *testing.T) {
+	site := &fakeSite{answers: map[string]int{"1": 3749, "2": 11387}}
+	c, clock := newTestClient(t, site.ServeHTTP)
+	ctx := context.Background()
+
+	steps := []struct {
+		part   int
+		answer string
+		want   Verdict
+		local  bool
+		wait   time.Duration
+	}{
+		{1, "4000", TooHigh, false, time.Minute},
+		// Still cooling down, so nothing is sent.
+		{1, "3000", Wait, true, time.Minute},
+		{1, "4000", TooHigh, true, 0},
+		{1, "5000", TooHigh, true, 0},
+		{1, "3749", Correct, false, 0},
+		{1, "3749", Correct, true, 0},
+		{1, "3750", Wrong, true, 0},
+		{2, "100", TooLow, false, 5 * time.Minute},
+		{2, "99", TooLow, true, 0},
+	}
+
+	for i, step := range steps {
+		// Let the previous cooldown pass unless this step checks for it.
+		if step.want != Wait {
+			clock.now = clock.now.Add(10 * time.Minute)
+		}
+
+		out, err := c.Submit(ctx, 7, step.part, step.answer)
+		if err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+		if out.Verdict != step.want || out.Local != step.local {
+			t.Errorf("step %d: submitting %s = %v, want %s (local %v)", i, step.answer, out, step.want, step.local)
+		}
+		if !step.local && out.Wait != step.wait {
+			t.Errorf("step %d: wait = %v, want %v", i, out.Wait, step.wait)
+		}
+	}
+
+	want := []string{"1:4000", "1:3749", "2:100"}
+	if fmt.Sprint(site.posts) != fmt.Sprint(want) {
+		t.Errorf("site received %v, want %v", site.posts, want)
+	}
+
+	history, err := c.History(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 3 {
+		t.Errorf("history has %d entries, want 3: %+v", len(history), history)
+	}
+}
+
+func TestSubmitTooRecently(t *testing.T) {
+	site := &fakeSite{answers: map[string]int{"1": 1}, recent: true}
+	c, clock := newTestClient(t, site.ServeHTTP)
+
+	out, err := c.Submit(context.Background(), 7, 1, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Verdict != Wait || out.Wait != 90*time.Second {
+		t.Fatalf("outcome = %v, want a 1m30s wait", out)
+	}
+
+	// The wait is remembered and the answer was not judged.
+	clock.now = clock.now.Add(30 * time.Second)
+	if out, _ := c.Submit(context.Background(), 7, 1, "1"); out.Verdict != Wait || !out.Local || out.Wait != time.Minute {
+		t.Errorf("outcome during cooldown = %v", out)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	if out, _ := c.Submit(context.Background(), 7, 1, "1"); out.Verdict != Correct {
+		t.Errorf("outcome after cooldown = %v", out)
+	}
+}
+
+func TestParseOutcomeRejectsUnknownPages(t *testing.T) {
+	if _, err := parseOutcome("<article><p>Something new</p></article>"); err == nil {
+		t.Error("expected an error for an unrecognised page")
+	}
+}
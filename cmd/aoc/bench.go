@@ -0,0 +1,112 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/reecepm/aoc-2024/bench"
+)
+
+const defaultBaseline = "bench/baseline.json"
+
+func benchCommand(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	format := fs.String("format", "markdown", "report format: markdown or json")
+	baselinePath := fs.String("baseline", defaultBaseline, "compare against the report stored at `path`")
+	threshold := fs.Float64("threshold", 0.2, "flag metrics that grow by more than this fraction of the baseline")
+	save := fs.Bool("save", false, "store this run as the new baseline instead of comparing")
+	example := fs.Bool("example", false, "benchmark each day's example input")
+	generate := fs.Bool("generate", false, "benchmark inputs made by each day's generator instead")
+	size := fs.Int("size", 0, "size of the generated inputs (default each generator's own)")
+	seed := fs.Uint64("seed", 1, "seed of the generated inputs")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	days, err := parseDays(positional)
+	if err != nil {
+		return err
+	}
+
+	if *format != "markdown" && *format != "json" {
+		return fmt.Errorf("bench: unknown format %q", *format)
+	}
+
+	if *generate && *example {
+		return fmt.Errorf("bench: --generate and --example cannot be combined")
+	}
+
+	var cases []bench.Case
+	if *generate {
+		cases, err = bench.Generated(days, *seed, *size)
+	} else {
+		cases, err = bench.Cases(".", days, *example)
+	}
+	if err != nil {
+		return fmt.Errorf("bench: %w", err)
+	}
+
+	report := make(bench.Report, 0, len(cases))
+	for _, c := range cases {
+		res := bench.Measure(c)
+		slog.Info("measured", "case", c.Name(), "ns/op", res.NsPerOp)
+		report = append(report, res)
+	}
+
+	if *save {
+		if err := report.Save(*baselinePath); err != nil {
+			return fmt.Errorf("bench: saving baseline: %w", err)
+		}
+		slog.Info("saved baseline", "path", *baselinePath)
+	}
+
+	// The stored baseline was measured on the real inputs, so generated
+	// inputs are only compared with a baseline named explicitly.
+	compare := !*save
+	if *generate {
+		compare = compare && flagSet(fs, "baseline")
+	}
+
+	var baseline bench.Report
+	if compare {
+		baseline, err = bench.LoadReport(*baselinePath)
+		switch {
+		case os.IsNotExist(err):
+			slog.Warn("no baseline; run with --save to create one", "path", *baselinePath)
+		case err != nil:
+			return fmt.Errorf("bench: %w", err)
+		}
+	}
+
+	if *format == "json" {
+		err = report.JSON(os.Stdout)
+	} else {
+		err = report.Markdown(os.Stdout, baseline, *threshold)
+	}
+	if err != nil {
+		return err
+	}
+
+	if regressions := bench.Compare(report, baseline, *threshold); len(regressions) > 0 {
+		for _, r := range regressions {
+			slog.Warn("regression", "case", r.Name, "metric", r.Metric, "baseline", r.Baseline, "current", r.Current)
+		}
+		return fmt.Errorf("bench: %d regressions against %s", len(regressions), *baselinePath)
+	}
+	return nil
+}
+
+// flagSet reports whether the named flag was given on the command line.
+func flagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/reecepm/aoc-2024/gen"
+)
+
+func checkCommand(args []string) error {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	seeds := fs.Uint64("seeds", 100, "check `n` generated inputs per day")
+	from := fs.Uint64("from", 0, "start at seed `n`")
+	timeout := fs.Duration("timeout", 10*time.Second, "give up on an input after `duration`")
+	out := fs.String("out", "", "write the first input a day fails on to `dir`")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	days, err := parseDays(positional)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, day := range days {
+		if err := checkDay(day, *from, *seeds, *timeout, *out); err != nil {
+			slog.Error("day failed", "day", day, "err", err)
+			failed++
+			continue
+		}
+		slog.Info("inputs match the reference", "day", day, "seeds", *seeds)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d days failed", failed, len(days))
+	}
+	return nil
+}
+
+// checkDay compares the day's solver with its reference on each seed, stopping
+// at the first input on which they disagree.
+func checkDay(day int, from, seeds uint64, timeout time.Duration, out string) error {
+	for seed := from; seed < from+seeds; seed++ {
+		in, err := gen.CheckInput(day, seed)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		mismatches, err := gen.Check(ctx, in)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("seed %d: %w", seed, err)
+		}
+		if len(mismatches) == 0 {
+			continue
+		}
+
+		for _, m := range mismatches {
+			slog.Warn("mismatch", "day", day, "seed", seed, "size", in.Size, "part", m.Part, "got", m.Got, "want", m.Want)
+		}
+		if out != "" {
+			path := fmt.Sprintf("%s/day%02d-seed%d.txt", out, day, seed)
+			if err := os.WriteFile(path, in.Data, 0o644); err != nil {
+				return err
+			}
+			slog.Info("input written", "day", day, "path", path)
+		}
+		return fmt.Errorf("seed %d disagrees with the reference", seed)
+	}
+	return nil
+}
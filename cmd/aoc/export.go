@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/render"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+func exportCommand(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	step := fs.Int("step", -1, "draw the frame after `step` in a PNG (default the last frame)")
+	every := fs.Int("every", 1, "keep every `n`th frame in a GIF")
+	cell := fs.Int("cell", render.DefaultCellSize, "draw each grid cell as `pixels` square")
+	delay := fs.Duration("delay", render.DefaultDelay, "show each GIF frame for `duration`")
+	palette := fs.String("palette", "", "override colours with `rune=hex` pairs, such as \"#=333,X=ffcc00\"")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return fmt.Errorf("export: expected a trace file and an output .png or .gif")
+	}
+	path := positional[1]
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext != ".png" && ext != ".gif" {
+		return fmt.Errorf("export: unsupported output %q, want .png or .gif", ext)
+	}
+	if *every < 1 {
+		return fmt.Errorf("export: --every must be at least 1")
+	}
+
+	overrides, err := render.ParsePalette(*palette)
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	opts := render.Options{
+		CellSize: *cell,
+		Palette:  render.DefaultPalette.With(overrides),
+		Delay:    *delay,
+	}
+
+	in, err := os.Open(positional[0])
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	defer in.Close()
+
+	t, err := trace.Read(bufio.NewReader(in))
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	if len(t.Start.Grid) == 0 {
+		return fmt.Errorf("export: day %d traces have no grid to draw", t.Start.Day)
+	}
+	p := trace.NewPlayer(t)
+
+	out, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	w := bufio.NewWriter(out)
+
+	var drawn string
+	if ext == ".png" {
+		if *step < 0 {
+			*step = p.Len()
+		}
+		p.Seek(*step)
+		err = render.PNG(w, p.Grid(), opts)
+		drawn = fmt.Sprintf("step %d/%d", p.Frame(), p.Len())
+	} else {
+		var frames [][]string
+		for {
+			if p.Frame()%*every == 0 || p.Frame() == p.Len() {
+				frames = append(frames, p.Grid())
+			}
+			if !p.Next() {
+				break
+			}
+		}
+		err = render.GIF(w, frames, opts)
+		drawn = fmt.Sprintf("%d of %d frames", len(frames), p.Len()+1)
+	}
+
+	if err = errors.Join(err, w.Flush(), out.Close()); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	slog.Info("export: drew "+drawn, "path", path)
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+
+	"github.com/reecepm/aoc-2024/client"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func fetchCommand(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	refresh := fs.Bool("refresh", false, "download again even when the input is cached")
+	stdout := fs.Bool("stdout", false, "print the input instead of writing day-NN/"+solver.InputFile)
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	days, err := parseDays(positional)
+	if err != nil {
+		return err
+	}
+	if *stdout && len(days) > 1 {
+		return fmt.Errorf("fetch: --stdout can only be used with a single day")
+	}
+
+	c, err := client.FromEnv()
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	for _, day := range days {
+		data, err := c.Input(ctx, day, *refresh)
+		if err != nil {
+			return err
+		}
+
+		if *stdout {
+			_, err := os.Stdout.Write(data)
+			return err
+		}
+
+		path := solver.InputPath(day, false)
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+		slog.Info("fetched", "day", day, "path", path, "bytes", len(data))
+	}
+	return nil
+}
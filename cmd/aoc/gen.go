@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/reecepm/aoc-2024/gen"
+)
+
+func genCommand(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	seed := fs.Uint64("seed", 1, "generate the input from `seed`")
+	size := fs.Int("size", 0, "size of the input (default close to the real input's)")
+	out := fs.String("out", "", "write the input to `path` instead of stdout")
+	list := fs.Bool("list", false, "list what size means for each day")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *list {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "DAY\tDEFAULT\tSIZE")
+		for _, day := range gen.Days() {
+			g, _ := gen.Lookup(day)
+			fmt.Fprintf(w, "%02d\t%d\t%s\n", day, g.Default, g.Size)
+		}
+		return w.Flush()
+	}
+
+	days, err := parseDays(positional)
+	if err != nil {
+		return err
+	}
+	if len(days) != 1 {
+		return fmt.Errorf("gen: expected a single day, got %d", len(days))
+	}
+
+	in, err := gen.New(days[0], *seed, *size)
+	if err != nil {
+		return fmt.Errorf("gen: %w", err)
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(in.Data)
+	} else {
+		err = os.WriteFile(*out, in.Data, 0o644)
+	}
+	if err != nil {
+		return fmt.Errorf("gen: %w", err)
+	}
+
+	if len(in.Params) > 0 {
+		var flags []string
+		for name, v := range in.Params {
+			flags = append(flags, fmt.Sprintf("--param %s=%d", name, v))
+		}
+		sort.Strings(flags)
+		slog.Info("gen: solve with these parameters", "day", in.Day, "flags", strings.Join(flags, " "))
+	}
+	return nil
+}
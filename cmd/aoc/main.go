@@ -0,0 +1,138 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/fatih/color"
+	_ "github.com/reecepm/aoc-2024/days"
+	"github.com/reecepm/aoc-2024/logging"
+)
+
+const usage = `usage: aoc [flags] <command> [arguments]
+
+flags:
+  --verbosity <level>           quiet (answers only), normal, debug or trace (drawings of
+                                solver state); default normal
+  --log-json                    log JSON lines instead of text
+
+commands:
+  run [flags] <day>... | all    solve the given days and report timings
+      --input <path>             read the input from path, or - for stdin
+      --example                  use each day's input.test.txt
+      --lenient                  skip malformed input lines instead of failing
+      --timeout <duration>       give up on a day after duration (default 1m, 0 for none)
+      --memo-stats               report memo cache hits and misses after each day
+      --memo-limit <name=n>      cap a memo cache at n entries; may be repeated
+      --trace <path>             record part one of day 6, 14, 15, 16 or 17 as JSON lines
+      --param <name=value>       override a puzzle parameter; may be repeated
+      --config <file>            read per-day parameters from JSON, e.g. {"14": {"width": 11}}
+      --reference                solve with each day's slow brute-force reference instead
+  replay [flags] <trace>        step through a trace recorded with run --trace
+      --step <n>                 print the frame after step n and exit
+      --play <delay>             play the whole trace with delay between frames
+  export [flags] <trace> <out.png|out.gif>
+                                draw a trace's grid as a PNG frame or an animated GIF
+      --step <n>                 draw the frame after step n in a PNG (default the last)
+      --every <n>                keep every nth frame in a GIF
+      --cell <pixels>            size of each grid cell (default 4)
+      --delay <duration>         time each GIF frame is shown (default 100ms)
+      --palette <rune=hex,...>   override colours, e.g. "#=333,X=ffcc00"
+  serve [flags]                 serve the browser visualizer and the JSON solve API
+      --addr <address>           listen address (default localhost:8024)
+      --timeout <duration>       give up on a run after duration (default 1m)
+  report [flags] <day>...       describe an input in more detail than its answers (days 01, 02)
+      --input, --example, --lenient, --param and --config as for run
+  params <day>... | all         list the puzzle parameters of the given days
+  bench [flags] <day>... | all  benchmark parsing and both parts of the given days
+      --format markdown|json     report format (default markdown)
+      --baseline <path>          compare against a stored report (default bench/baseline.json)
+      --threshold <fraction>     allowed growth before flagging a regression (default 0.2)
+      --save                     store this run as the baseline
+      --example                  benchmark each day's input.test.txt
+      --generate                 benchmark generated inputs; compared only with an explicit --baseline
+      --size <n>                 size of the generated inputs
+      --seed <n>                 seed of the generated inputs (default 1)
+  gen [flags] <day>             print a random input for day, the same for the same seed and size
+      --seed <n>                 seed of the input (default 1)
+      --size <n>                 size of the input, such as the side of a map (see --list)
+      --out <path>               write the input to path instead of stdout
+      --list                     list each day's size and its default
+  check [flags] <day>... | all  compare solvers with their brute-force references on small generated inputs
+      --seeds <n>                inputs to check per day (default 100)
+      --from <n>                 first seed to check (default 0)
+      --timeout <duration>       give up on an input after duration (default 10s)
+      --out <dir>                write the first input a day fails on to dir
+  fetch [flags] <day>... | all  download inputs into day-NN/input.txt using $AOC_SESSION
+      --refresh                  download again even when the input is cached
+      --stdout                   print the input instead of writing it
+  submit [flags] <day> <part>   post an answer using $AOC_SESSION; known-wrong answers are never resent
+      --answer <value>           submit value instead of solving day-NN/input.txt
+  vault keygen                  print a new key for $AOC_INPUT_KEY
+  vault encrypt [flags] <day>... | all
+                                seal day-NN/input.txt as day-NN/input.txt.enc
+      --remove                   delete the plain input afterwards
+  vault decrypt <day>... | all  restore day-NN/input.txt from its sealed copy
+`
+
+func main() {
+	fs := flag.NewFlagSet("aoc", flag.ExitOnError)
+	fs.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	verbosity := fs.String("verbosity", "normal", "show logs at `level`: quiet, normal, debug or trace")
+	logJSON := fs.Bool("log-json", false, "log JSON lines instead of text")
+	fs.Parse(os.Args[1:])
+
+	level, err := logging.ParseVerbosity(*verbosity)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "aoc: %v\n", err)
+		os.Exit(2)
+	}
+	if *logJSON {
+		color.NoColor = true
+	}
+	slog.SetDefault(logging.New(os.Stderr, level, *logJSON))
+
+	if fs.NArg() < 1 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch cmd, args := fs.Arg(0), fs.Args()[1:]; cmd {
+	case "run":
+		err = runCommand(args)
+	case "bench":
+		err = benchCommand(args)
+	case "gen":
+		err = genCommand(args)
+	case "check":
+		err = checkCommand(args)
+	case "replay":
+		err = replayCommand(args)
+	case "export":
+		err = exportCommand(args)
+	case "serve":
+		err = serveCommand(args)
+	case "report":
+		err = reportCommand(args)
+	case "params":
+		err = paramsCommand(args)
+	case "fetch":
+		err = fetchCommand(args)
+	case "submit":
+		err = submitCommand(args)
+	case "vault":
+		err = vaultCommand(args)
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n\n%s", cmd, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		slog.Error("aoc: " + err.Error())
+		os.Exit(1)
+	}
+}
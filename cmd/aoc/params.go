@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+// paramFlag collects repeated --param name=value flags.
+type paramFlag solver.Params
+
+func (p paramFlag) String() string {
+	parts := make([]string, 0, len(p))
+	for name, v := range p {
+		parts = append(parts, fmt.Sprintf("%s=%d", name, v))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func (p paramFlag) Set(s string) error {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("expected name=value, got %q", s)
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("parameter %s: %w", name, err)
+	}
+	p[name] = v
+	return nil
+}
+
+type paramOptions struct {
+	overrides  paramFlag
+	configPath string
+	config     map[int]solver.Params
+}
+
+func (o *paramOptions) register(fs *flag.FlagSet) {
+	o.overrides = make(paramFlag)
+	fs.Var(o.overrides, "param", "override a puzzle parameter as `name=value`; may be repeated")
+	fs.StringVar(&o.configPath, "config", "", "read per-day parameters from a JSON `file` such as {\"14\": {\"width\": 11}}")
+}
+
+// load reads the config file and checks that every command line override is
+// declared by at least one of the selected days.
+func (o *paramOptions) load(days []int) error {
+	if o.configPath != "" {
+		data, err := os.ReadFile(o.configPath)
+		if err != nil {
+			return err
+		}
+
+		var raw map[string]solver.Params
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("decoding %s: %w", o.configPath, err)
+		}
+
+		o.config = make(map[int]solver.Params, len(raw))
+		for key, params := range raw {
+			day, err := strconv.Atoi(key)
+			if err != nil {
+				return fmt.Errorf("%s: invalid day %q", o.configPath, key)
+			}
+			o.config[day] = params
+		}
+	}
+
+	for name := range o.overrides {
+		if !declaredByAny(days, name) {
+			return fmt.Errorf("no selected day has a parameter %q", name)
+		}
+	}
+	return nil
+}
+
+// forDay merges the config file and command line values that apply to day,
+// with the command line taking precedence.
+func (o *paramOptions) forDay(day int) solver.Params {
+	params := make(solver.Params)
+	for name, v := range o.config[day] {
+		params[name] = v
+	}
+
+	for _, p := range solver.ParamsOf(day) {
+		if v, ok := o.overrides[p.Name]; ok {
+			params[p.Name] = v
+		}
+	}
+	return params
+}
+
+func declaredByAny(days []int, name string) bool {
+	for _, day := range days {
+		for _, p := range solver.ParamsOf(day) {
+			if p.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func paramsCommand(args []string) error {
+	fs := flag.NewFlagSet("params", flag.ExitOnError)
+	fs.Parse(args)
+
+	days, err := parseDays(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "DAY\tNAME\tDEFAULT\tEXAMPLE\tRANGE\tUSAGE")
+	for _, day := range days {
+		for _, p := range solver.ParamsOf(day) {
+			example := "-"
+			if p.Example != 0 {
+				example = strconv.Itoa(p.Example)
+			}
+			fmt.Fprintf(w, "%02d\t%s\t%d\t%s\t%s\t%s\n", day, p.Name, p.Default, example, p.Range(), p.Usage)
+		}
+	}
+	return w.Flush()
+}
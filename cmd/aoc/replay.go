@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+const replayHelp = "[n]ext [p]rev [g]o <step> [q]uit; n and p take a count"
+
+// clearScreen moves the cursor home and clears the terminal between frames.
+const clearScreen = "\x1b[H\x1b[2J"
+
+func replayCommand(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	step := fs.Int("step", -1, "print the frame after `step` and exit")
+	play := fs.Duration("play", 0, "play the trace with `delay` between frames instead of stepping")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("replay: expected a trace file")
+	}
+
+	f, err := os.Open(positional[0])
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	defer f.Close()
+
+	t, err := trace.Read(bufio.NewReader(f))
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	p := trace.NewPlayer(t)
+
+	switch {
+	case *step >= 0:
+		p.Seek(*step)
+		return p.Render(os.Stdout)
+	case *play > 0:
+		for {
+			fmt.Print(clearScreen)
+			if err := p.Render(os.Stdout); err != nil {
+				return err
+			}
+			if !p.Next() {
+				return nil
+			}
+			time.Sleep(*play)
+		}
+	}
+	return stepThrough(p, os.Stdin, os.Stdout)
+}
+
+// stepThrough renders a frame and then reads a command per line, until the
+// input ends or asks to quit.
+func stepThrough(p *trace.Player, in io.Reader, out io.Writer) error {
+	lines := bufio.NewScanner(in)
+	for {
+		if err := p.Render(out); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%s> ", replayHelp)
+		if !lines.Scan() {
+			fmt.Fprintln(out)
+			return lines.Err()
+		}
+
+		cmd, arg, _ := strings.Cut(strings.TrimSpace(lines.Text()), " ")
+		n := 1
+		if arg != "" {
+			v, err := strconv.Atoi(strings.TrimSpace(arg))
+			if err != nil {
+				fmt.Fprintf(out, "invalid number %q\n", arg)
+				continue
+			}
+			n = v
+		}
+
+		switch cmd {
+		case "", "n":
+			p.Seek(p.Frame() + n)
+		case "p":
+			p.Seek(p.Frame() - n)
+		case "g":
+			p.Seek(n)
+		case "q":
+			return nil
+		default:
+			fmt.Fprintf(out, "unknown command %q\n", cmd)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func reportCommand(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	var input inputOptions
+	input.register(fs)
+	var params paramOptions
+	params.register(fs)
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	days, err := parseDays(positional)
+	if err != nil {
+		return err
+	}
+	if err := input.validate(days); err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+	if err := params.load(days); err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	for i, day := range days {
+		if len(days) > 1 {
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			fmt.Fprintf(out, "day %02d\n\n", day)
+		}
+		path := input.resolve(day)
+		opts := solver.Options{
+			Example: solver.IsExamplePath(path),
+			Params:  params.forDay(day),
+			Mode:    input.mode(),
+		}
+		if err := reportDay(day, path, opts, out); err != nil {
+			return errors.Join(fmt.Errorf("report: day %02d: %w", day, err), out.Flush())
+		}
+	}
+	return out.Flush()
+}
+
+func reportDay(day int, path string, opts solver.Options, out *bufio.Writer) error {
+	s, err := solver.New(day, opts)
+	if err != nil {
+		return err
+	}
+	r, ok := s.(solver.Reporter)
+	if !ok {
+		return fmt.Errorf("no report for this day")
+	}
+
+	f, err := solver.OpenInput(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return r.Report(f, out)
+}
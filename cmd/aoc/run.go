@@ -0,0 +1,269 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/reecepm/aoc-2024/logging"
+	"github.com/reecepm/aoc-2024/memo"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+type inputOptions struct {
+	path    string
+	example bool
+	lenient bool
+}
+
+func (o inputOptions) resolve(day int) string {
+	if o.path != "" {
+		return o.path
+	}
+	return solver.InputPath(day, o.example)
+}
+
+func (o inputOptions) validate(days []int) error {
+	if o.path != "" && o.example {
+		return fmt.Errorf("--input and --example are mutually exclusive")
+	}
+	if o.path != "" && len(days) > 1 {
+		return fmt.Errorf("--input can only be used with a single day")
+	}
+	return nil
+}
+
+func (o *inputOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.path, "input", "", "read the puzzle input from `path`, or - for stdin")
+	fs.BoolVar(&o.example, "example", false, "use the day's "+solver.ExampleFile+" instead of "+solver.InputFile)
+	fs.BoolVar(&o.lenient, "lenient", false, "skip malformed input lines instead of failing")
+}
+
+func (o inputOptions) mode() parse.Mode {
+	if o.lenient {
+		return parse.Lenient
+	}
+	return parse.Strict
+}
+
+func runCommand(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	var input inputOptions
+	input.register(fs)
+	var params paramOptions
+	params.register(fs)
+	timeout := fs.Duration("timeout", time.Minute, "give up on a day after `duration`; 0 means no limit")
+	memoStats := fs.Bool("memo-stats", false, "report memo cache hits and misses after each day")
+	memoLimits := make(paramFlag)
+	fs.Var(memoLimits, "memo-limit", "cap the memo cache `name=entries`, such as day11/stones=1000; may be repeated")
+	tracePath := fs.String("trace", "", "record part one of a simulation day to `path` as JSON lines")
+	reference := fs.Bool("reference", false, "solve with each day's brute-force reference solver")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	days, err := parseDays(positional)
+	if err != nil {
+		return err
+	}
+
+	if err := input.validate(days); err != nil {
+		return fmt.Errorf("run: %w", err)
+	}
+	if err := params.load(days); err != nil {
+		return fmt.Errorf("run: %w", err)
+	}
+
+	for name, max := range memoLimits {
+		memo.SetLimit(name, max)
+	}
+
+	var (
+		tracer   *trace.Tracer
+		traceOut *traceFile
+	)
+	if *tracePath != "" {
+		if len(days) != 1 {
+			return fmt.Errorf("run: --trace can only be used with a single day")
+		}
+		f, err := os.Create(*tracePath)
+		if err != nil {
+			return fmt.Errorf("run: %w", err)
+		}
+		defer f.Close()
+		traceOut = newTraceFile(f)
+		tracer = trace.New(traceOut.emit)
+	}
+
+	failed := 0
+	for _, day := range days {
+		path := input.resolve(day)
+		opts := solver.Options{
+			Example:   solver.IsExamplePath(path),
+			Params:    params.forDay(day),
+			Mode:      input.mode(),
+			Tracer:    tracer,
+			Reference: *reference,
+			Logger:    slog.With("day", day),
+		}
+
+		memo.ResetReport()
+		if err := runDay(day, path, opts, *timeout); err != nil {
+			slog.Error("day failed", "day", day, "err", err)
+			failed++
+		}
+		if *memoStats {
+			for _, e := range memo.Report() {
+				slog.Info("memo", "day", day, "cache", e.Name, "hits", e.Stats.Hits, "misses", e.Stats.Misses, "evictions", e.Stats.Evictions)
+			}
+		}
+	}
+
+	if tracer != nil {
+		steps, err := traceOut.close()
+		if err != nil {
+			return fmt.Errorf("run: %w", err)
+		}
+		slog.Info("trace written", "day", days[0], "steps", steps, "path", *tracePath)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d days failed", failed, len(days))
+	}
+	return nil
+}
+
+// errTraceClosed stops a tracer that outlives its trace file.
+var errTraceClosed = errors.New("trace file closed")
+
+// traceFile writes trace events as JSON lines. A part that times out keeps
+// tracing on its abandoned goroutine, so events are written one at a time and
+// none follow close.
+type traceFile struct {
+	mu     sync.Mutex
+	out    *bufio.Writer
+	enc    *json.Encoder
+	steps  int
+	err    error
+	closed bool
+}
+
+func newTraceFile(w io.Writer) *traceFile {
+	out := bufio.NewWriter(w)
+	return &traceFile{out: out, enc: json.NewEncoder(out)}
+}
+
+func (t *traceFile) emit(e trace.Event) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return errTraceClosed
+	}
+	if err := t.enc.Encode(e); err != nil {
+		t.err = fmt.Errorf("trace: step %d: %w", e.Step, err)
+		return err
+	}
+	t.steps = e.Step
+	return nil
+}
+
+// close drops any later events and flushes the trace, returning the number of
+// steps written.
+func (t *traceFile) close() (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	return t.steps, errors.Join(t.err, t.out.Flush())
+}
+
+func runDay(day int, path string, opts solver.Options, timeout time.Duration) error {
+	f, err := solver.OpenInput(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	res, err := solver.Run(ctx, day, f, opts)
+	if res == nil {
+		return err
+	}
+
+	slog.Info("parsed", "day", day, "took", res.ParseTime)
+	logPart(day, 1, res.PartOne, res.PartOneTime)
+	logPart(day, 2, res.PartTwo, res.PartTwoTime)
+
+	var perr *solver.PartError
+	if errors.As(err, &perr) && errors.Is(err, solver.ErrTimedOut) {
+		return fmt.Errorf("part%d timed out after %v", perr.Part, timeout)
+	}
+	return err
+}
+
+func logPart(day, part int, answer any, took time.Duration) {
+	if answer == nil {
+		return
+	}
+	slog.Log(context.Background(), logging.LevelAnswer, "answer", "day", day, "part", part, "answer", answer, "took", took)
+}
+
+// parseInterspersed lets flags appear before, between or after positional
+// arguments, so that "aoc run 14 --example" works like "aoc run --example 14".
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func parseDays(args []string) ([]int, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("run: expected a day number or \"all\"")
+	}
+
+	if len(args) == 1 && args[0] == "all" {
+		return solver.Days(), nil
+	}
+
+	days := make([]int, 0, len(args))
+	for _, arg := range args {
+		day, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("run: invalid day %q", arg)
+		}
+		if _, ok := solver.Lookup(day); !ok {
+			return nil, fmt.Errorf("run: no solver registered for day %d", day)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/reecepm/aoc-2024/web"
+)
+
+func serveCommand(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:8024", "listen on `address`")
+	timeout := fs.Duration("timeout", web.DefaultTimeout, "give up on a run after `duration`")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return fmt.Errorf("serve: unexpected argument %q", positional[0])
+	}
+
+	s := &web.Server{Root: ".", Timeout: *timeout}
+	slog.Info("serve: visualizer at / and solve API at POST /days/{n}/solve", "url", "http://"+*addr+"/")
+	return http.ListenAndServe(*addr, s.Handler())
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"github.com/reecepm/aoc-2024/client"
+	"github.com/reecepm/aoc-2024/logging"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func submitCommand(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	answer := fs.String("answer", "", "submit `value` instead of solving the day's "+solver.InputFile)
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return fmt.Errorf("submit: expected a day and a part")
+	}
+
+	day, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("submit: invalid day %q", positional[0])
+	}
+	part, err := strconv.Atoi(positional[1])
+	if err != nil || part < 1 || part > 2 {
+		return fmt.Errorf("submit: part must be 1 or 2, got %q", positional[1])
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if *answer == "" {
+		if *answer, err = solve(ctx, day, part); err != nil {
+			return err
+		}
+	}
+
+	c, err := client.FromEnv()
+	if err != nil {
+		return fmt.Errorf("submit: %w", err)
+	}
+
+	out, err := c.Submit(ctx, day, part, *answer)
+	if err != nil {
+		return err
+	}
+	slog.Log(ctx, logging.LevelAnswer, "submitted", "day", day, "part", part, "answer", *answer, "outcome", out)
+
+	if out.Verdict != client.Correct && out.Verdict != client.Finished {
+		return fmt.Errorf("answer not accepted")
+	}
+	return nil
+}
+
+// solve computes one part's answer from the day's real input.
+func solve(ctx context.Context, day, part int) (string, error) {
+	f, err := solver.OpenInput(solver.InputPath(day, false))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	res, err := solver.Run(ctx, day, f, solver.Options{})
+	if err != nil {
+		return "", err
+	}
+
+	answer := res.PartOne
+	if part == 2 {
+		answer = res.PartTwo
+	}
+	if answer == nil {
+		return "", fmt.Errorf("day %d has no part %d answer", day, part)
+	}
+	return fmt.Sprint(answer), nil
+}
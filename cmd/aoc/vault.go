@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/vault"
+)
+
+const vaultUsage = "vault: expected keygen, encrypt or decrypt"
+
+func vaultCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New(vaultUsage)
+	}
+
+	switch cmd, args := args[0], args[1:]; cmd {
+	case "keygen":
+		key, err := vault.GenerateKey()
+		if err != nil {
+			return err
+		}
+		fmt.Println(key)
+		return nil
+	case "encrypt":
+		return sealCommand(args)
+	case "decrypt":
+		return unsealCommand(args)
+	default:
+		return errors.New(vaultUsage)
+	}
+}
+
+// sealCommand migrates plain inputs to sealed copies next to them.
+func sealCommand(args []string) error {
+	fs := flag.NewFlagSet("vault encrypt", flag.ExitOnError)
+	remove := fs.Bool("remove", false, "delete each plain input once its sealed copy is written")
+
+	days, v, err := vaultArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	for _, day := range days {
+		path := solver.InputPath(day, false)
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			slog.Warn("no input, skipping", "day", day, "path", path)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		sealed, err := v.Seal(vault.Name(path), data)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path+vault.Ext, sealed, 0o644); err != nil {
+			return err
+		}
+
+		if *remove {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+		slog.Info("sealed", "day", day, "path", path+vault.Ext)
+	}
+	return nil
+}
+
+// unsealCommand restores plain inputs from their sealed copies.
+func unsealCommand(args []string) error {
+	days, _, err := vaultArgs(flag.NewFlagSet("vault decrypt", flag.ExitOnError), args)
+	if err != nil {
+		return err
+	}
+
+	for _, day := range days {
+		path := solver.InputPath(day, false)
+		data, err := vault.ReadSealed(path)
+		if errors.Is(err, os.ErrNotExist) {
+			slog.Warn("no sealed input, skipping", "day", day, "path", path+vault.Ext)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+		slog.Info("unsealed", "day", day, "path", path)
+	}
+	return nil
+}
+
+func vaultArgs(fs *flag.FlagSet, args []string) ([]int, *vault.Vault, error) {
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	days, err := parseDays(positional)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v, err := vault.FromEnv()
+	if err != nil {
+		return nil, nil, fmt.Errorf("vault: %w", err)
+	}
+	return days, v, nil
+}
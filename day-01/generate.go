@@ -0,0 +1,33 @@
+package day01
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(1, gen.Generator{Size: "location ID pairs", Default: 1000, Generate: generate, CheckSize: 30})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 1)
+	left := make([]int, size)
+	for i := range left {
+		left[i] = 10000 + rng.IntN(90000)
+	}
+
+	var b strings.Builder
+	for _, id := range left {
+		// Some of the right list repeats the left so similarity is not zero.
+		right := 10000 + rng.IntN(90000)
+		if rng.IntN(3) == 0 {
+			right = left[rng.IntN(size)]
+		}
+		fmt.Fprintf(&b, "%d   %d\n", id, right)
+	}
+	return b.String(), nil
+}
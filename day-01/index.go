@@ -0,0 +1,346 @@
+package day01
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+// Side picks one of the two location lists.
+type Side int
+
+const (
+	Left Side = iota
+	Right
+)
+
+// SimilarityIndex keeps the distance and similarity score of two location
+// lists up to date as IDs are inserted and removed one at a time.
+//
+// The similarity score is a running sum. The distance uses the fact that
+// pairing two sorted lists of equal length costs the area between their
+// counting functions: the sum over every x of |L(x) - R(x)|, where L(x) and
+// R(x) count the IDs up to x in each list. The IDs present split the line into
+// segments on which that difference is constant. The segments are kept in
+// blocks of about the square root of their number. An insertion or removal
+// changes the difference on every segment from its ID onwards, which costs a
+// rebuild of one block and a lazy shift of the rest, and reading the distance
+// adds up one total per block.
+type SimilarityIndex struct {
+	counts     [2]map[int]int
+	lens       [2]int
+	similarity int
+	blocks     []*block
+}
+
+// segment covers the IDs from id up to the next ID present.
+type segment struct {
+	id int
+	// diff is L(id) - R(id), less the lazy shift of its block.
+	diff int
+	// width is the next ID present minus id, or zero for the last segment.
+	width int
+}
+
+// block holds consecutive segments and the area they cover.
+type block struct {
+	segs []segment
+	// lazy is added to the diff of every segment in the block.
+	lazy int
+	// widths totals the width of the segments by their stored diff.
+	widths map[int]int
+	// nonNeg and neg total the widths where diff+lazy is at least zero and
+	// below zero.
+	nonNeg, neg int
+	// area is the sum of width * |diff+lazy|.
+	area int
+}
+
+// NewSimilarityIndex returns an index over copies of the two lists.
+func NewSimilarityIndex(left, right []int) *SimilarityIndex {
+	idx := &SimilarityIndex{counts: [2]map[int]int{make(map[int]int), make(map[int]int)}}
+	for side, list := range [2][]int{left, right} {
+		for _, id := range list {
+			idx.counts[side][id]++
+		}
+		idx.lens[side] = len(list)
+	}
+	for id, n := range idx.counts[Left] {
+		idx.similarity += id * n * idx.counts[Right][id]
+	}
+
+	var ids []int
+	for side := range idx.counts {
+		for id := range idx.counts[side] {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	segs := make([]segment, len(ids))
+	diff := 0
+	for i, id := range ids {
+		diff += idx.counts[Left][id] - idx.counts[Right][id]
+		segs[i] = segment{id: id, diff: diff}
+		if i+1 < len(ids) {
+			segs[i].width = ids[i+1] - id
+		}
+	}
+	size := blockSize(len(segs))
+	for len(segs) > 0 {
+		n := min(size, len(segs))
+		idx.blocks = append(idx.blocks, newBlock(slices.Clone(segs[:n])))
+		segs = segs[n:]
+	}
+	return idx
+}
+
+// Len returns how many IDs one side holds.
+func (idx *SimilarityIndex) Len(side Side) int {
+	return idx.lens[side]
+}
+
+// Count returns how many times id appears on one side.
+func (idx *SimilarityIndex) Count(side Side, id int) int {
+	return idx.counts[side][id]
+}
+
+// Similarity returns the similarity score: each left ID times the number of
+// times it appears on the right.
+func (idx *SimilarityIndex) Similarity() int {
+	return idx.similarity
+}
+
+// Distance returns the total distance between the lists paired smallest to
+// smallest. Lists of different lengths cannot be paired, and report false.
+func (idx *SimilarityIndex) Distance() (int, bool) {
+	if idx.lens[Left] != idx.lens[Right] {
+		return 0, false
+	}
+	total := 0
+	for _, b := range idx.blocks {
+		total += b.area
+	}
+	return total, true
+}
+
+// Insert adds id to one side.
+func (idx *SimilarityIndex) Insert(side Side, id int) {
+	idx.similarity += id * idx.counts[other(side)][id]
+	idx.counts[side][id]++
+	idx.lens[side]++
+
+	bi, si := idx.ensure(id)
+	idx.shift(bi, si, step(side))
+}
+
+// Remove takes one id off a side, reporting false if the side has none.
+func (idx *SimilarityIndex) Remove(side Side, id int) bool {
+	if idx.counts[side][id] == 0 {
+		return false
+	}
+	idx.counts[side][id]--
+	if idx.counts[side][id] == 0 {
+		delete(idx.counts[side], id)
+	}
+	idx.lens[side]--
+	idx.similarity -= id * idx.counts[other(side)][id]
+
+	bi, si := idx.find(id)
+	idx.shift(bi, si, -step(side))
+	if idx.counts[Left][id] == 0 && idx.counts[Right][id] == 0 {
+		idx.drop(bi, si)
+	}
+	return true
+}
+
+func other(side Side) Side { return 1 - side }
+
+// step is how an ID on side changes L - R.
+func step(side Side) int {
+	if side == Left {
+		return 1
+	}
+	return -1
+}
+
+// find returns the block and position of the segment starting at id, or of
+// where it would go.
+func (idx *SimilarityIndex) find(id int) (int, int) {
+	bi := sort.Search(len(idx.blocks), func(i int) bool { return idx.blocks[i].segs[0].id > id }) - 1
+	if bi < 0 {
+		return 0, 0
+	}
+	segs := idx.blocks[bi].segs
+	return bi, sort.Search(len(segs), func(i int) bool { return segs[i].id >= id })
+}
+
+// ensure adds a segment starting at id if there is none and returns its place.
+func (idx *SimilarityIndex) ensure(id int) (int, int) {
+	if len(idx.blocks) == 0 {
+		idx.blocks = []*block{newBlock([]segment{{id: id}})}
+		return 0, 0
+	}
+	bi, si := idx.find(id)
+	b := idx.blocks[bi]
+	if si < len(b.segs) && b.segs[si].id == id {
+		return bi, si
+	}
+
+	// The new segment carries on the difference of the one before it and
+	// ends where that one used to.
+	seg := segment{id: id}
+	if pb, pi, ok := idx.prev(bi, si); ok {
+		p := idx.blocks[pb]
+		prev := p.segs[pi]
+		seg.diff = prev.diff + p.lazy - b.lazy
+		if prev.width > 0 {
+			seg.width = prev.id + prev.width - id
+		}
+		p.setWidth(pi, id-prev.id)
+	} else {
+		seg.diff = -b.lazy
+		seg.width = b.segs[0].id - id
+	}
+	b.insert(si, seg)
+
+	if len(b.segs) > 2*blockSize(idx.segments()) {
+		half := len(b.segs) / 2
+		b.flatten()
+		tail := newBlock(slices.Clone(b.segs[half:]))
+		idx.blocks[bi] = newBlock(b.segs[:half])
+		idx.blocks = slices.Insert(idx.blocks, bi+1, tail)
+		if si >= half {
+			return bi + 1, si - half
+		}
+	}
+	return bi, si
+}
+
+// drop removes the segment at si in block bi, whose IDs are all gone, so
+// the segment before it reaches as far as it did.
+func (idx *SimilarityIndex) drop(bi, si int) {
+	b := idx.blocks[bi]
+	seg := b.segs[si]
+	if pb, pi, ok := idx.prev(bi, si); ok {
+		p := idx.blocks[pb]
+		width := 0
+		if seg.width > 0 {
+			width = p.segs[pi].width + seg.width
+		}
+		p.setWidth(pi, width)
+	}
+	b.remove(si)
+	if len(b.segs) == 0 {
+		idx.blocks = slices.Delete(idx.blocks, bi, bi+1)
+	}
+}
+
+// prev returns the place of the segment before the one at si in block bi.
+func (idx *SimilarityIndex) prev(bi, si int) (int, int, bool) {
+	if si > 0 {
+		return bi, si - 1, true
+	}
+	if bi > 0 {
+		return bi - 1, len(idx.blocks[bi-1].segs) - 1, true
+	}
+	return 0, 0, false
+}
+
+// shift adds delta to the difference of every segment from si in block bi
+// onwards.
+func (idx *SimilarityIndex) shift(bi, si, delta int) {
+	b := idx.blocks[bi]
+	for i := si; i < len(b.segs); i++ {
+		b.setDiff(i, b.segs[i].diff+delta)
+	}
+	for _, b := range idx.blocks[bi+1:] {
+		b.shift(delta)
+	}
+}
+
+func (idx *SimilarityIndex) segments() int {
+	n := 0
+	for _, b := range idx.blocks {
+		n += len(b.segs)
+	}
+	return n
+}
+
+// blockSize is the number of segments a block starts with.
+func blockSize(segments int) int {
+	return max(32, int(math.Sqrt(float64(segments))))
+}
+
+func newBlock(segs []segment) *block {
+	b := &block{segs: segs, widths: make(map[int]int)}
+	for _, seg := range segs {
+		b.count(seg, 1)
+	}
+	return b
+}
+
+// count adds a segment's width to the block's totals, or takes it away when
+// sign is -1.
+func (b *block) count(seg segment, sign int) {
+	w := sign * seg.width
+	if b.widths[seg.diff] += w; b.widths[seg.diff] == 0 {
+		delete(b.widths, seg.diff)
+	}
+	if e := seg.diff + b.lazy; e >= 0 {
+		b.nonNeg += w
+		b.area += w * e
+	} else {
+		b.neg += w
+		b.area -= w * e
+	}
+}
+
+func (b *block) insert(i int, seg segment) {
+	b.segs = slices.Insert(b.segs, i, seg)
+	b.count(seg, 1)
+}
+
+func (b *block) remove(i int) {
+	b.count(b.segs[i], -1)
+	b.segs = slices.Delete(b.segs, i, i+1)
+}
+
+func (b *block) setDiff(i, diff int) {
+	b.count(b.segs[i], -1)
+	b.segs[i].diff = diff
+	b.count(b.segs[i], 1)
+}
+
+func (b *block) setWidth(i, width int) {
+	b.count(b.segs[i], -1)
+	b.segs[i].width = width
+	b.count(b.segs[i], 1)
+}
+
+// shift adds delta, 1 or -1, to the difference of every segment in the
+// block. Only the segments whose difference crosses zero move between the
+// totals, so this takes constant time.
+func (b *block) shift(delta int) {
+	if delta > 0 {
+		b.area += b.nonNeg - b.neg
+		crossing := b.widths[-1-b.lazy]
+		b.nonNeg += crossing
+		b.neg -= crossing
+	} else {
+		zero := b.widths[-b.lazy]
+		b.area += b.neg + zero - (b.nonNeg - zero)
+		b.nonNeg -= zero
+		b.neg += zero
+	}
+	b.lazy += delta
+}
+
+// flatten folds the lazy shift into every segment.
+func (b *block) flatten() {
+	for i := range b.segs {
+		b.segs[i].diff += b.lazy
+	}
+	b.lazy = 0
+}
@@ -0,0 +1,150 @@
+package day01
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/reecepm/aoc-2024/parse"
+)
+
+// Reconciliation compares any number of location lists, each a column of
+// the input, pair by pair.
+type Reconciliation struct {
+	Lists [][]int
+	// Distances holds the total distance between every pair of lists, as in
+	// part one.
+	Distances [][]int
+	// Similarities holds the similarity score of every pair of lists, as in
+	// part two. Each ID counts once for every pair of copies, so the matrix is
+	// symmetric.
+	Similarities [][]int
+	// Discrepancies holds what each list has too few or too many of compared
+	// with the others.
+	Discrepancies []Discrepancies
+}
+
+// Discrepancies are the IDs one list disagrees with the others about.
+type Discrepancies struct {
+	// Missing holds the IDs that other lists have but this one does not.
+	Missing []int
+	// Excess holds the IDs this list has more copies of than any other list
+	// that has the ID at all.
+	Excess []Excess
+}
+
+// Excess is an ID that one list holds more often than the others.
+type Excess struct {
+	ID int
+	// Count is how often the list holds the ID.
+	Count int
+	// Others is the most copies of the ID any other list holds.
+	Others int
+}
+
+// ParseLists reads rows of any number of whitespace-separated IDs into one
+// list per column. Every row must have as many columns as the first.
+func ParseLists(r io.Reader, mode parse.Mode) ([][]int, error) {
+	return parseColumns(r, mode, 0)
+}
+
+// Reconcile compares every pair of lists, which must be of equal length.
+func Reconcile(lists [][]int) *Reconciliation {
+	n := len(lists)
+	rec := &Reconciliation{
+		Lists:         lists,
+		Distances:     make([][]int, n),
+		Similarities:  make([][]int, n),
+		Discrepancies: make([]Discrepancies, n),
+	}
+	for i := range lists {
+		rec.Distances[i] = make([]int, n)
+		rec.Similarities[i] = make([]int, n)
+		for j := range lists {
+			rec.Distances[i][j] = part1(lists[i], lists[j])
+			rec.Similarities[i][j] = part2(lists[i], lists[j])
+		}
+	}
+
+	counts := make([]map[int]int, n)
+	ids := make(map[int]bool)
+	for i, list := range lists {
+		counts[i] = make(map[int]int)
+		for _, id := range list {
+			counts[i][id]++
+			ids[id] = true
+		}
+	}
+	for _, id := range slices.Sorted(maps.Keys(ids)) {
+		for i := range lists {
+			others := 0
+			for j := range lists {
+				if j != i {
+					others = max(others, counts[j][id])
+				}
+			}
+			d := &rec.Discrepancies[i]
+			switch count := counts[i][id]; {
+			case count == 0:
+				d.Missing = append(d.Missing, id)
+			case others > 0 && count > others:
+				d.Excess = append(d.Excess, Excess{ID: id, Count: count, Others: others})
+			}
+		}
+	}
+	return rec
+}
+
+// WriteReport writes both matrices and each list's discrepancies as text.
+func (rec *Reconciliation) WriteReport(w io.Writer) error {
+	names := make([]string, len(rec.Lists))
+	for i := range names {
+		names[i] = fmt.Sprintf("list %d", i+1)
+	}
+
+	for _, m := range []struct {
+		title  string
+		values [][]int
+	}{{"distance", rec.Distances}, {"similarity", rec.Similarities}} {
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintf(tw, "%s\t%s\t\n", m.title, strings.Join(names, "\t"))
+		for i, row := range m.values {
+			fmt.Fprintf(tw, "%s", names[i])
+			for _, v := range row {
+				fmt.Fprintf(tw, "\t%d", v)
+			}
+			fmt.Fprint(tw, "\t\n")
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	}
+
+	for i, d := range rec.Discrepancies {
+		fmt.Fprintf(w, "%s (%d IDs)\n", names[i], len(rec.Lists[i]))
+		missing := make([]string, len(d.Missing))
+		for j, id := range d.Missing {
+			missing[j] = fmt.Sprint(id)
+		}
+		excess := make([]string, len(d.Excess))
+		for j, e := range d.Excess {
+			excess[j] = fmt.Sprintf("%d (%d here, at most %d elsewhere)", e.ID, e.Count, e.Others)
+		}
+		fmt.Fprintf(w, "  missing: %s\n", orNone(missing))
+		if _, err := fmt.Fprintf(w, "  over-represented: %s\n", orNone(excess)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func orNone(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ", ")
+}
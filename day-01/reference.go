@@ -0,0 +1,39 @@
+package day01
+
+// referencePartOne pairs the lists by repeatedly taking the smallest
+// remaining number from each.
+func referencePartOne(arr1, arr2 []int) int {
+	left, right := append([]int(nil), arr1...), append([]int(nil), arr2...)
+	total := 0
+	for len(left) > 0 && len(right) > 0 {
+		i, j := smallest(left), smallest(right)
+		total += abs(left[i] - right[j])
+		left = append(left[:i], left[i+1:]...)
+		right = append(right[:j], right[j+1:]...)
+	}
+	return total
+}
+
+func smallest(nums []int) int {
+	at := 0
+	for i, n := range nums {
+		if n < nums[at] {
+			at = i
+		}
+	}
+	return at
+}
+
+// referencePartTwo counts each left number's appearances in the right list
+// by scanning it.
+func referencePartTwo(arr1, arr2 []int) int {
+	total := 0
+	for _, a := range arr1 {
+		for _, b := range arr2 {
+			if a == b {
+				total += a
+			}
+		}
+	}
+	return total
+}
@@ -0,0 +1,180 @@
+package day01
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+var params = []solver.Param{
+	{Name: "stream-run", Usage: "stream the lists through sorted runs of this many IDs spilled to disk, for inputs too large for memory; 0 holds them in memory", Default: 0},
+}
+
+type solution struct {
+	mode       parse.Mode
+	arr1, arr2 []int
+	params     solver.Params
+	reference  bool
+	// streamed holds both answers when the input was streamed instead of
+	// being read into arr1 and arr2.
+	streamed *Totals
+}
+
+func init() {
+	solver.Register(1, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) Parse(r io.Reader) error {
+	if run := s.params["stream-run"]; run > 0 && !s.reference {
+		totals, err := Stream(r, s.mode, StreamOptions{RunSize: run})
+		s.streamed = &totals
+		return err
+	}
+
+	var err error
+	s.arr1, s.arr2, err = parseInput(r, s.mode)
+	return err
+}
+
+// Report compares every column of the input, of which there may be any
+// number, with every other.
+func (s *solution) Report(r io.Reader, w io.Writer) error {
+	lists, err := ParseLists(r, s.mode)
+	if err != nil {
+		return err
+	}
+	return Reconcile(lists).WriteReport(w)
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.arr1, s.arr2)
+	}
+	if s.streamed != nil {
+		return s.streamed.Distance
+	}
+	return part1(s.arr1, s.arr2)
+}
+
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referencePartTwo(s.arr1, s.arr2)
+	}
+	if s.streamed != nil {
+		return s.streamed.Similarity
+	}
+	return part2(s.arr1, s.arr2)
+}
+
+func part1(arr1 []int, arr2 []int) int {
+	sorted1 := make([]int, len(arr1))
+	sorted2 := make([]int, len(arr2))
+	copy(sorted1, arr1)
+	copy(sorted2, arr2)
+
+	slices.Sort(sorted1)
+	slices.Sort(sorted2)
+
+	total := 0
+	for i := range sorted1 {
+		total += abs(sorted1[i] - sorted2[i])
+	}
+	return total
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func part2(arr1 []int, arr2 []int) int {
+	secondItems := make(map[int]int, len(arr2))
+
+	for _, v := range arr2 {
+		secondItems[v]++
+	}
+
+	total := 0
+
+	for _, v := range arr1 {
+		if count, exists := secondItems[v]; exists {
+			total += count * v
+		}
+	}
+
+	return total
+}
+
+func parseInput(r io.Reader, mode parse.Mode) ([]int, []int, error) {
+	lists, err := parseColumns(r, mode, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lists[0], lists[1], nil
+}
+
+// parseColumns reads rows of whitespace-separated IDs into one list per
+// column. Every row must have the given number of columns, or as many as the
+// first row when columns is zero.
+func parseColumns(r io.Reader, mode parse.Mode, columns int) ([][]int, error) {
+	lists := make([][]int, columns)
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		fields := parse.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(lists) == 0 {
+			lists = make([][]int, len(fields))
+		}
+
+		row, err := parseRow(scanner, fields, len(lists))
+		if err != nil {
+			if mode == parse.Lenient {
+				continue
+			}
+			return nil, err
+		}
+		for i, id := range row {
+			lists[i] = append(lists[i], id)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range lists {
+		if lists[i] == nil {
+			lists[i] = make([]int, 0)
+		}
+	}
+	return lists, nil
+}
+
+func parseRow(scanner *parse.Scanner, fields []parse.Field, columns int) ([]int, error) {
+	if len(fields) != columns {
+		return nil, scanner.Errorf(0, "expected %d numbers, got %d fields", columns, len(fields))
+	}
+
+	row := make([]int, columns)
+	for i, f := range fields {
+		n, err := scanner.Int(f)
+		if err != nil {
+			return nil, err
+		}
+		row[i] = n
+	}
+	return row, nil
+}
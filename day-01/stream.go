@@ -0,0 +1,389 @@
+package day01
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/reecepm/aoc-2024/parse"
+)
+
+// StreamOptions bound the memory Stream uses.
+type StreamOptions struct {
+	// RunSize is how many IDs of each list are sorted in memory before they
+	// are spilled to a temporary file as a sorted run.
+	RunSize int
+	// FanIn is the most runs merged at once. Lists with more runs are merged
+	// in several rounds, which bounds the open files.
+	FanIn int
+	// Dir holds the temporary files. Empty means os.TempDir.
+	Dir string
+}
+
+// DefaultStreamOptions keep about sixteen megabytes of IDs in memory.
+var DefaultStreamOptions = StreamOptions{RunSize: 1 << 20, FanIn: 64}
+
+// Totals are the answers to both parts for a pair of lists.
+type Totals struct {
+	Distance   int
+	Similarity int
+}
+
+// Stream computes the total distance and similarity score of the lists in r
+// without holding them in memory. Each list is cut into sorted runs spilled
+// to temporary files, which are then merged: once walking both lists in
+// step for the distance, and once joining equal IDs for the similarity.
+func Stream(r io.Reader, mode parse.Mode, opts StreamOptions) (Totals, error) {
+	if opts.RunSize <= 0 {
+		opts.RunSize = DefaultStreamOptions.RunSize
+	}
+	if opts.FanIn < 2 {
+		opts.FanIn = DefaultStreamOptions.FanIn
+	}
+	dir, err := os.MkdirTemp(opts.Dir, "day01-*")
+	if err != nil {
+		return Totals{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	left := &runSet{dir: dir, limit: opts.RunSize, fanIn: opts.FanIn}
+	right := &runSet{dir: dir, limit: opts.RunSize, fanIn: opts.FanIn}
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		fields := parse.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		row, err := parseRow(scanner, fields, 2)
+		if err != nil {
+			if mode == parse.Lenient {
+				continue
+			}
+			return Totals{}, err
+		}
+		if err := errors.Join(left.add(row[0]), right.add(row[1])); err != nil {
+			return Totals{}, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Totals{}, err
+	}
+	if err := errors.Join(left.finish(), right.finish()); err != nil {
+		return Totals{}, err
+	}
+
+	distance, err := streamDistance(left, right)
+	if err != nil {
+		return Totals{}, err
+	}
+	similarity, err := streamSimilarity(left, right)
+	if err != nil {
+		return Totals{}, err
+	}
+	return Totals{Distance: distance, Similarity: similarity}, nil
+}
+
+// streamDistance pairs the merged lists in order, like part1.
+func streamDistance(left, right *runSet) (int, error) {
+	l, err := left.open()
+	if err != nil {
+		return 0, err
+	}
+	defer l.close()
+	r, err := right.open()
+	if err != nil {
+		return 0, err
+	}
+	defer r.close()
+
+	total := 0
+	for {
+		a, okA, err := l.next()
+		if err != nil {
+			return 0, err
+		}
+		b, okB, err := r.next()
+		if err != nil {
+			return 0, err
+		}
+		if !okA || !okB {
+			return total, nil
+		}
+		total += abs(a - b)
+	}
+}
+
+// streamSimilarity joins the merged lists on equal IDs, adding each ID times
+// how often it appears on both sides, like part2.
+func streamSimilarity(left, right *runSet) (int, error) {
+	l, err := left.open()
+	if err != nil {
+		return 0, err
+	}
+	defer l.close()
+	r, err := right.open()
+	if err != nil {
+		return 0, err
+	}
+	defer r.close()
+
+	total := 0
+	a, okA, err := l.next()
+	if err != nil {
+		return 0, err
+	}
+	b, okB, err := r.next()
+	if err != nil {
+		return 0, err
+	}
+	for okA && okB {
+		switch {
+		case a < b:
+			a, okA, err = l.next()
+		case b < a:
+			b, okB, err = r.next()
+		default:
+			id, countA, countB := a, 0, 0
+			for okA && a == id && err == nil {
+				countA++
+				a, okA, err = l.next()
+			}
+			for okB && b == id && err == nil {
+				countB++
+				b, okB, err = r.next()
+			}
+			total += id * countA * countB
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// runSet sorts one list in runs of at most limit IDs. Runs are kept in
+// memory until a second one is needed, so small lists never touch the disk.
+type runSet struct {
+	dir   string
+	limit int
+	fanIn int
+	buf   []int
+	files []string
+}
+
+func (s *runSet) add(n int) error {
+	if len(s.buf) == s.limit {
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	s.buf = append(s.buf, n)
+	return nil
+}
+
+// spill sorts the buffered IDs and writes them to a new run file.
+func (s *runSet) spill() error {
+	slices.Sort(s.buf)
+	err := s.write(func(w *bufio.Writer) error {
+		var scratch [binary.MaxVarintLen64]byte
+		for _, n := range s.buf {
+			if _, err := w.Write(binary.AppendVarint(scratch[:0], int64(n))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	s.buf = s.buf[:0]
+	return err
+}
+
+// write creates a run file with the IDs fill writes and adds it to the set.
+func (s *runSet) write(fill func(*bufio.Writer) error) error {
+	f, err := os.CreateTemp(s.dir, "run-*")
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := errors.Join(fill(w), w.Flush(), f.Close()); err != nil {
+		return err
+	}
+	s.files = append(s.files, f.Name())
+	return nil
+}
+
+// finish spills what is left once some runs are on disk, then merges runs
+// until at most fanIn are left.
+func (s *runSet) finish() error {
+	if len(s.files) == 0 {
+		slices.Sort(s.buf)
+		return nil
+	}
+	if len(s.buf) > 0 {
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	s.buf = nil
+
+	for len(s.files) > s.fanIn {
+		group := s.files[:s.fanIn]
+		s.files = s.files[s.fanIn:]
+		if err := s.mergeFiles(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeFiles replaces the runs in files with a single run holding them all.
+func (s *runSet) mergeFiles(files []string) error {
+	m, err := openMerge(files)
+	if err != nil {
+		return err
+	}
+	defer m.close()
+
+	err = s.write(func(w *bufio.Writer) error {
+		var scratch [binary.MaxVarintLen64]byte
+		for {
+			n, ok, err := m.next()
+			if err != nil || !ok {
+				return err
+			}
+			if _, err := w.Write(binary.AppendVarint(scratch[:0], int64(n))); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// open returns the set's IDs in ascending order.
+func (s *runSet) open() (*merger, error) {
+	if len(s.files) == 0 {
+		return &merger{sources: []*runReader{{nums: s.buf}}}, nil
+	}
+	return openMerge(s.files)
+}
+
+// runReader reads one sorted run, from a file or from memory.
+type runReader struct {
+	f    *os.File
+	r    *bufio.Reader
+	nums []int
+}
+
+func (rr *runReader) next() (int, bool, error) {
+	if rr.r == nil {
+		if len(rr.nums) == 0 {
+			return 0, false, nil
+		}
+		n := rr.nums[0]
+		rr.nums = rr.nums[1:]
+		return n, true, nil
+	}
+	n, err := binary.ReadVarint(rr.r)
+	if errors.Is(err, io.EOF) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("reading run %s: %w", rr.f.Name(), err)
+	}
+	return int(n), true, nil
+}
+
+// merger merges sorted runs by keeping the next ID of each in a heap.
+type merger struct {
+	sources []*runReader
+	heap    mergeHeap
+	primed  bool
+}
+
+func openMerge(files []string) (*merger, error) {
+	m := &merger{}
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			m.close()
+			return nil, err
+		}
+		m.sources = append(m.sources, &runReader{f: f, r: bufio.NewReader(f)})
+	}
+	return m, nil
+}
+
+// next returns the smallest ID not yet returned, or false once every run is
+// exhausted.
+func (m *merger) next() (int, bool, error) {
+	if !m.primed {
+		m.primed = true
+		m.heap = m.heap[:0]
+		for i, src := range m.sources {
+			n, ok, err := src.next()
+			if err != nil {
+				return 0, false, err
+			}
+			if ok {
+				m.heap = append(m.heap, mergeItem{n, i})
+			}
+		}
+		heap.Init(&m.heap)
+	}
+	if len(m.heap) == 0 {
+		return 0, false, nil
+	}
+
+	top := m.heap[0]
+	n, ok, err := m.sources[top.at].next()
+	if err != nil {
+		return 0, false, err
+	}
+	if ok {
+		m.heap[0].n = n
+		heap.Fix(&m.heap, 0)
+	} else {
+		heap.Pop(&m.heap)
+	}
+	return top.n, true, nil
+}
+
+func (m *merger) close() {
+	for _, src := range m.sources {
+		if src.f != nil {
+			src.f.Close()
+		}
+	}
+}
+
+type mergeItem struct {
+	n  int
+	at int
+}
+
+type mergeHeap []mergeItem
+
+func (h mergeHeap) Len() int           { return len(h) }
+func (h mergeHeap) Less(i, j int) bool { return h[i].n < h[j].n }
+func (h mergeHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *mergeHeap) Push(x any)        { *h = append(*h, x.(mergeItem)) }
+func (h *mergeHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,149 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Rule is a safety rule a report can break.
+type Rule int
+
+const (
+	// NoRule is the rule a safe report breaks.
+	NoRule Rule = iota
+	// DirectionChange is a step against the direction of the first one.
+	DirectionChange
+	// StepOutOfRange is a step by less than the minimum or more than the
+	// maximum.
+	StepOutOfRange
+	// WrongDirection is a step against the direction the rules allow.
+	WrongDirection
+)
+
+func (r Rule) String() string {
+	switch r {
+	case DirectionChange:
+		return "direction change"
+	case StepOutOfRange:
+		return "step out of range"
+	case WrongDirection:
+		return "wrong direction"
+	}
+	return "none"
+}
+
+// Diagnosis explains why a report is safe or not.
+type Diagnosis struct {
+	Levels []int
+	Safe   bool
+	// Violation is the index of the first level that breaks a rule, stepping
+	// from the one before it, or -1 for a safe report.
+	Violation int
+	// Rule is the rule the violation breaks.
+	Rule Rule
+	// Step is the difference from the level before the violation.
+	Step int
+	// Removals is the fewest levels the Problem Dampener must remove to make
+	// the report safe, or -1 if that takes more than it may remove.
+	Removals int
+	// Fixes holds the index of every level whose removal alone makes an
+	// unsafe report safe. It is empty for safe reports and for ones the
+	// Problem Dampener cannot save.
+	Fixes []int
+}
+
+// Diagnose checks one report against DefaultRules, with a Problem Dampener
+// that may remove one level.
+func Diagnose(levels []int) Diagnosis {
+	return DefaultRules.Diagnose(levels, 1)
+}
+
+// DiagnoseAll checks every report against DefaultRules, with a Problem
+// Dampener that may remove one level.
+func DiagnoseAll(reports [][]int) []Diagnosis {
+	return DefaultRules.DiagnoseAll(reports, 1)
+}
+
+// Diagnose checks one report against the rules, with a Problem Dampener that
+// may remove up to k levels.
+func (r Rules) Diagnose(levels []int, k int) Diagnosis {
+	d := Diagnosis{Levels: levels}
+	d.Violation, d.Rule = r.firstViolation(levels)
+	if d.Violation < 0 {
+		d.Safe = true
+		return d
+	}
+	d.Step = levels[d.Violation] - levels[d.Violation-1]
+	if d.Removals = r.Removals(levels, k); d.Removals != 1 {
+		return d
+	}
+
+	rest := make([]int, 0, len(levels)-1)
+	for skip := range levels {
+		rest = append(append(rest[:0], levels[:skip]...), levels[skip+1:]...)
+		if r.Safe(rest) {
+			d.Fixes = append(d.Fixes, skip)
+		}
+	}
+	return d
+}
+
+// DiagnoseAll checks every report against the rules, with a Problem
+// Dampener that may remove up to k levels.
+func (r Rules) DiagnoseAll(reports [][]int, k int) []Diagnosis {
+	ds := make([]Diagnosis, len(reports))
+	for i, levels := range reports {
+		ds[i] = r.Diagnose(levels, k)
+	}
+	return ds
+}
+
+// WriteDiagnoses writes a table of diagnoses, numbering reports and levels
+// from one, and a count of each outcome under a Problem Dampener that may
+// remove up to k levels.
+func WriteDiagnoses(w io.Writer, ds []Diagnosis, k int) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "REPORT\tLEVELS\tSAFE\tVIOLATION\tRULE\tSTEP\tFIX")
+	var safe, fixed int
+	for i, d := range ds {
+		levels := strings.Trim(fmt.Sprint(d.Levels), "[]")
+		switch {
+		case d.Safe:
+			safe++
+			fmt.Fprintf(tw, "%d\t%s\tyes\t-\t-\t-\t-\n", i+1, levels)
+			continue
+		case d.Removals > 0:
+			fixed++
+		}
+
+		fix := "none"
+		switch {
+		case len(d.Fixes) > 0:
+			var removals []string
+			for _, at := range d.Fixes {
+				removals = append(removals, fmt.Sprintf("%d (%d)", at+1, d.Levels[at]))
+			}
+			fix = "remove level " + strings.Join(removals, " or ")
+		case d.Removals > 0:
+			fix = fmt.Sprintf("remove %d levels", d.Removals)
+		}
+		fmt.Fprintf(tw, "%d\t%s\tno\tlevel %d (%d)\t%v\t%+d\t%s\n",
+			i+1, levels, d.Violation+1, d.Levels[d.Violation], d.Rule, d.Step, fix)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	var dampened string
+	switch {
+	case k == 1:
+		dampened = fmt.Sprintf(", %d safe after removing one level", fixed)
+	case k > 1:
+		dampened = fmt.Sprintf(", %d safe after removing up to %d levels", fixed, k)
+	}
+	_, err := fmt.Fprintf(w, "\n%d reports: %d safe%s, %d unsafe\n",
+		len(ds), safe, dampened, len(ds)-safe-fixed)
+	return err
+}
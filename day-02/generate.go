@@ -0,0 +1,36 @@
+package day02
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(2, gen.Generator{Size: "reports", Default: 1000, Generate: generate, CheckSize: 30})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var b strings.Builder
+	for range max(size, 1) {
+		levels := make([]string, 5+rng.IntN(4))
+		level, dir := 10+rng.IntN(50), 1
+		if rng.IntN(2) == 0 {
+			dir = -1
+		}
+		for i := range levels {
+			levels[i] = strconv.Itoa(level)
+			level += dir * (1 + rng.IntN(3))
+		}
+		// Half the reports start safe and get one level knocked out of line.
+		if rng.IntN(2) == 0 {
+			levels[rng.IntN(len(levels))] = strconv.Itoa(1 + rng.IntN(99))
+		}
+		b.WriteString(strings.Join(levels, " "))
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
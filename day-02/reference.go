@@ -0,0 +1,60 @@
+package day02
+
+// referenceSafe checks a report against the rules exactly as stated: every
+// step within the bounds, none against the allowed direction and, for
+// monotonic rules, not some increasing and some decreasing.
+func referenceSafe(levels []int, rules Rules) bool {
+	up, down := false, false
+	for i := 1; i < len(levels); i++ {
+		diff := levels[i] - levels[i-1]
+		if abs(diff) < rules.MinStep || abs(diff) > rules.MaxStep {
+			return false
+		}
+		up = up || diff > 0
+		down = down || diff < 0
+	}
+	switch {
+	case rules.Direction == Increasing && down, rules.Direction == Decreasing && up:
+		return false
+	case rules.Monotonic && up && down:
+		return false
+	}
+	return true
+}
+
+func referencePartOne(reports [][]int, rules Rules) int {
+	count := 0
+	for _, levels := range reports {
+		if referenceSafe(levels, rules) {
+			count++
+		}
+	}
+	return count
+}
+
+// referencePartTwo tries the report as it is and without every combination
+// of up to k of its levels.
+func referencePartTwo(reports [][]int, rules Rules, k int) int {
+	count := 0
+	for _, levels := range reports {
+		if referenceSafeAfter(levels, rules, k) {
+			count++
+		}
+	}
+	return count
+}
+
+func referenceSafeAfter(levels []int, rules Rules, k int) bool {
+	if referenceSafe(levels, rules) {
+		return true
+	}
+	for skip := 0; skip < len(levels) && k > 0; skip++ {
+		var rest []int
+		rest = append(rest, levels[:skip]...)
+		rest = append(rest, levels[skip+1:]...)
+		if referenceSafeAfter(rest, rules, k-1) {
+			return true
+		}
+	}
+	return false
+}
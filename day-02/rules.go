@@ -0,0 +1,129 @@
+package day02
+
+import "fmt"
+
+// Direction is which way a report's levels may run.
+type Direction int
+
+const (
+	Decreasing      Direction = -1
+	EitherDirection Direction = 0
+	Increasing      Direction = 1
+)
+
+// Rules are the safety rules a report must follow.
+type Rules struct {
+	// MinStep and MaxStep bound how far apart adjacent levels may be.
+	MinStep, MaxStep int
+	// Monotonic requires every step to go the same way as the first one that
+	// is not zero. Steps of zero, when MinStep allows them, go neither way.
+	Monotonic bool
+	// Direction, unless EitherDirection, is the only way steps may go.
+	Direction Direction
+}
+
+// DefaultRules are the puzzle's: all increasing or all decreasing, by one to
+// three at a time.
+var DefaultRules = Rules{MinStep: 1, MaxStep: 3, Monotonic: true}
+
+// Validate reports rules no report could follow or that make no sense.
+func (r Rules) Validate() error {
+	switch {
+	case r.MinStep < 0:
+		return fmt.Errorf("minimum step %d is negative", r.MinStep)
+	case r.MaxStep < r.MinStep:
+		return fmt.Errorf("maximum step %d is below the minimum %d", r.MaxStep, r.MinStep)
+	case r.Direction < Decreasing || r.Direction > Increasing:
+		return fmt.Errorf("direction %d is not -1, 0 or 1", r.Direction)
+	}
+	return nil
+}
+
+// Safe reports whether the levels follow the rules.
+func (r Rules) Safe(levels []int) bool {
+	at, _ := r.firstViolation(levels)
+	return at < 0
+}
+
+// Removals returns the fewest levels to remove for a report to follow the
+// rules, or -1 if that takes more than k. Removing all but one level always
+// leaves a safe report.
+//
+// For each way the levels may run, best[i] is the fewest removals that leave
+// a safe report ending with level i. The level kept before i is at most k+1
+// back, since more would remove too many in between, so the pass takes
+// O(n·k) time rather than trying every set of removals.
+func (r Rules) Removals(levels []int, k int) int {
+	n := len(levels)
+	if n == 0 {
+		return 0
+	}
+
+	fewest := -1
+	best := make([]int, n)
+	for _, dir := range r.directions() {
+		for i := range levels {
+			best[i] = i
+			for j := max(0, i-k-1); j < i; j++ {
+				if removed := best[j] + i - j - 1; removed < best[i] && r.step(levels[i]-levels[j], dir) {
+					best[i] = removed
+				}
+			}
+			if removed := best[i] + n - 1 - i; removed <= k && (fewest < 0 || removed < fewest) {
+				fewest = removed
+			}
+		}
+	}
+	return fewest
+}
+
+// directions returns each way the steps may all go, where 0 leaves them free
+// to change.
+func (r Rules) directions() []Direction {
+	switch {
+	case r.Direction != EitherDirection:
+		return []Direction{r.Direction}
+	case r.Monotonic:
+		return []Direction{Increasing, Decreasing}
+	}
+	return []Direction{EitherDirection}
+}
+
+// step reports whether a step of diff is allowed in a report running dir.
+func (r Rules) step(diff int, dir Direction) bool {
+	if d := abs(diff); d < r.MinStep || d > r.MaxStep {
+		return false
+	}
+	return diff == 0 || dir == EitherDirection || (diff > 0) == (dir == Increasing)
+}
+
+// firstViolation returns the index of the first level that breaks a rule and
+// the rule, or -1 when the levels are safe. A step of zero goes in neither
+// direction, so the first step that is not zero sets the direction.
+func (r Rules) firstViolation(nums []int) (int, Rule) {
+	dir := r.Direction
+	for i := 1; i < len(nums); i++ {
+		diff := nums[i] - nums[i-1]
+
+		if diff != 0 {
+			increasing := diff > 0
+			switch {
+			case r.Direction != EitherDirection && increasing != (r.Direction == Increasing):
+				return i, WrongDirection
+			case r.Monotonic && dir != EitherDirection && increasing != (dir == Increasing):
+				return i, DirectionChange
+			case dir == EitherDirection && r.Monotonic:
+				dir = Decreasing
+				if increasing {
+					dir = Increasing
+				}
+			}
+		}
+
+		if d := abs(diff); d < r.MinStep || d > r.MaxStep {
+			return i, StepOutOfRange
+		}
+	}
+
+	return -1, NoRule
+}
@@ -0,0 +1,142 @@
+package day02
+
+import (
+	"context"
+	"io"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+var params = []solver.Param{
+	{Name: "min-step", Usage: "smallest difference allowed between adjacent levels", Default: 1},
+	{Name: "max-step", Usage: "largest difference allowed between adjacent levels", Default: 3},
+	{Name: "monotonic", Usage: "1 if every step must go the same way, 0 if not", Default: 1, Max: 1},
+	{Name: "direction", Usage: "1 for increasing reports only, -1 for decreasing, 0 for either", Default: 0, Min: -1, Max: 1},
+	{Name: "part2-removals", Usage: "levels the Problem Dampener may remove", Default: 1},
+}
+
+type solution struct {
+	mode      parse.Mode
+	grid      [][]int
+	params    solver.Params
+	reference bool
+}
+
+func init() {
+	solver.Register(2, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) Parse(r io.Reader) error {
+	if err := s.check(); err != nil {
+		return err
+	}
+	var err error
+	s.grid, err = parseInput(r, s.mode)
+	return err
+}
+
+// Report diagnoses every report: the first level breaking a rule and the
+// levels whose removal would make it safe, up to part2-removals of them.
+func (s *solution) Report(r io.Reader, w io.Writer) error {
+	if err := s.check(); err != nil {
+		return err
+	}
+	reports, err := parseInput(r, s.mode)
+	if err != nil {
+		return err
+	}
+	k := s.params["part2-removals"]
+	return WriteDiagnoses(w, s.rules().DiagnoseAll(reports, k), k)
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.grid, s.rules())
+	}
+	return partOne(s.grid, s.rules())
+}
+
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referencePartTwo(s.grid, s.rules(), s.params["part2-removals"])
+	}
+	return partTwo(s.grid, s.rules(), s.params["part2-removals"])
+}
+
+func (s *solution) rules() Rules {
+	return Rules{
+		MinStep:   s.params["min-step"],
+		MaxStep:   s.params["max-step"],
+		Monotonic: s.params["monotonic"] != 0,
+		Direction: Direction(s.params["direction"]),
+	}
+}
+
+// check rejects parameters no report could be judged by.
+func (s *solution) check() error {
+	return s.rules().Validate()
+}
+
+func partOne(grid [][]int, rules Rules) int {
+	return countSafeSequences(grid, rules, 0)
+}
+
+func partTwo(grid [][]int, rules Rules, removals int) int {
+	return countSafeSequences(grid, rules, removals)
+}
+
+// countSafeSequences counts the reports made safe by removing at most k
+// levels.
+func countSafeSequences(grid [][]int, rules Rules, k int) int {
+	totalSafe := 0
+
+	for _, row := range grid {
+		if rules.Removals(row, k) >= 0 {
+			totalSafe++
+		}
+	}
+
+	return totalSafe
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func parseInput(r io.Reader, mode parse.Mode) ([][]int, error) {
+	arr := make([][]int, 0)
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		fields := parse.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		parsed := make([]int, 0, len(fields))
+		for _, field := range fields {
+			num, err := scanner.Int(field)
+			if err != nil {
+				// Lenient parsing keeps the rest of the report.
+				if mode == parse.Lenient {
+					continue
+				}
+				return nil, err
+			}
+			parsed = append(parsed, num)
+		}
+		arr = append(arr, parsed)
+	}
+
+	return arr, scanner.Err()
+}
@@ -0,0 +1,43 @@
+package day03
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(3, gen.Generator{Size: "instructions", Default: 800, Generate: generate, CheckSize: 40})
+}
+
+// corrupted are near misses that must not be read as instructions.
+var corrupted = []string{"mul[3,7]", "mul(4*", "mul(32,64]", "mul(1234,5)", "do_not_", "don't", "mul(,2)", "?(12,34)"}
+
+const noise = "%&!@^*()[]{}<>+-,'#$~:;/whatfromselectwhy"
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var b strings.Builder
+	for i := range max(size, 1) {
+		switch n := rng.IntN(10); {
+		case n < 6:
+			fmt.Fprintf(&b, "mul(%d,%d)", 1+rng.IntN(999), 1+rng.IntN(999))
+		case n == 6:
+			b.WriteString("do()")
+		case n == 7:
+			b.WriteString("don't()")
+		default:
+			b.WriteString(corrupted[rng.IntN(len(corrupted))])
+		}
+		for range rng.IntN(8) {
+			b.WriteByte(noise[rng.IntN(len(noise))])
+		}
+		if i%100 == 99 {
+			b.WriteByte('\n')
+		}
+	}
+	b.WriteByte('\n')
+	return b.String(), nil
+}
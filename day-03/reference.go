@@ -0,0 +1,49 @@
+package day03
+
+import "strings"
+
+// referenceSum walks the memory one character at a time, reading mul(X,Y)
+// with X and Y of one to three digits, and do() and don't() when conditional.
+func referenceSum(input string, conditional bool) int {
+	sum := 0
+	enabled := true
+	for i := range input {
+		rest := input[i:]
+		switch {
+		case conditional && strings.HasPrefix(rest, "do()"):
+			enabled = true
+		case conditional && strings.HasPrefix(rest, "don't()"):
+			enabled = false
+		case strings.HasPrefix(rest, "mul("):
+			x, n := readNumber(rest[4:])
+			if n == 0 || 4+n >= len(rest) || rest[4+n] != ',' {
+				continue
+			}
+			y, m := readNumber(rest[5+n:])
+			if m == 0 || 5+n+m >= len(rest) || rest[5+n+m] != ')' {
+				continue
+			}
+			if enabled {
+				sum += x * y
+			}
+		}
+	}
+	return sum
+}
+
+// readNumber reads a number of one to three digits from the start of s and
+// returns it with the digits read, or zero digits if there is none.
+func readNumber(s string) (int, int) {
+	value, digits := 0, 0
+	for digits < len(s) && s[digits] >= '0' && s[digits] <= '9' {
+		value = value*10 + int(s[digits]-'0')
+		digits++
+	}
+	if digits > 3 {
+		return 0, 0
+	}
+	return value, digits
+}
+
+func referencePartOne(input string) int { return referenceSum(input, false) }
+func referencePartTwo(input string) int { return referenceSum(input, true) }
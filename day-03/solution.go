@@ -1,25 +1,44 @@
-package main
+package day03
 
 import (
-	"log"
-	"os"
+	"context"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/reecepm/aoc-2024/solver"
 )
 
-func main() {
-	data, err := os.ReadFile("input.txt")
-	if err != nil {
-		log.Fatal(err)
-	}
+type solution struct {
+	input     string
+	reference bool
+}
 
-	input := strings.ReplaceAll(string(data), "\n", "")
-	input = strings.ReplaceAll(input, "\r", "")
-	input = strings.ReplaceAll(input, " ", "")
+func init() {
+	solver.Register(3, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
 
-	log.Printf("part1: %d", partOne(input))
-	log.Printf("part2: %d", partTwo(input))
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.input, err = parseInput(r)
+	return err
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.input)
+	}
+	return partOne(s.input)
+}
+
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referencePartTwo(s.input)
+	}
+	return partTwo(s.input)
 }
 
 func extractNumsFromMul(mulStr string) (int, int) {
@@ -30,7 +49,7 @@ func extractNumsFromMul(mulStr string) (int, int) {
 }
 
 func partOne(input string) int {
-	re := regexp.MustCompile(`mul\(\d+,\d+\)`)
+	re := regexp.MustCompile(`mul\(\d{1,3},\d{1,3}\)`)
 	sum := 0
 	matches := re.FindAllString(input, -1)
 	for _, match := range matches {
@@ -41,7 +60,7 @@ func partOne(input string) int {
 }
 
 func partTwo(input string) int {
-	re := regexp.MustCompile(`mul\(\d+,\d+\)|do\(\)|don't\(\)`)
+	re := regexp.MustCompile(`mul\(\d{1,3},\d{1,3}\)|do\(\)|don't\(\)`)
 	sum := 0
 	enabled := true
 
@@ -61,3 +80,15 @@ func partTwo(input string) int {
 	}
 	return sum
 }
+
+func parseInput(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	input := strings.ReplaceAll(string(data), "\n", "")
+	input = strings.ReplaceAll(input, "\r", "")
+	input = strings.ReplaceAll(input, " ", "")
+	return input, nil
+}
@@ -0,0 +1,23 @@
+package day04
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(4, gen.Generator{Size: "side of the word search", Default: 140, Generate: generate, CheckSize: 12})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 4)
+	g := gen.NewGrid(size, size, '.')
+	for _, row := range g {
+		for x := range row {
+			row[x] = "XMAS"[rng.IntN(4)]
+		}
+	}
+	return g.String(), nil
+}
@@ -0,0 +1,39 @@
+package day04
+
+import "github.com/reecepm/aoc-2024/grid"
+
+// referenceWord reads up to n letters from start in direction dir.
+func referenceWord(g *grid.Grid[rune], start, dir grid.Point, n int) string {
+	var word []rune
+	for p := start; len(word) < n && g.Contains(p); p = p.Add(dir) {
+		word = append(word, g.At(p))
+	}
+	return string(word)
+}
+
+// referencePartOne reads the four letters in every direction from every
+// cell.
+func referencePartOne(g *grid.Grid[rune]) int {
+	count := 0
+	for pos := range g.All() {
+		for _, dir := range grid.Compass {
+			if referenceWord(g, pos, dir, 4) == "XMAS" {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// referencePartTwo reads both diagonals of every three by three square.
+func referencePartTwo(g *grid.Grid[rune]) int {
+	count := 0
+	for pos := range g.All() {
+		down := referenceWord(g, pos, grid.DownRight, 3)
+		up := referenceWord(g, pos.Add(grid.Down.Mul(2)), grid.UpRight, 3)
+		if (down == "MAS" || down == "SAM") && (up == "MAS" || up == "SAM") {
+			count++
+		}
+	}
+	return count
+}
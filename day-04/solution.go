@@ -0,0 +1,113 @@
+package day04
+
+import (
+	"context"
+	"io"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Position struct {
+	pos grid.Point
+	dir grid.Point
+}
+
+type solution struct {
+	grid      *grid.Grid[rune]
+	reference bool
+}
+
+func init() {
+	solver.Register(4, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.grid, err = parseInput(r)
+	return err
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.grid)
+	}
+	return partOne(s.grid)
+}
+
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referencePartTwo(s.grid)
+	}
+	return partTwo(s.grid)
+}
+
+func partOne(g *grid.Grid[rune]) int {
+	return len(findWord(g, "XMAS"))
+}
+
+func partTwo(g *grid.Grid[rune]) int {
+	if g.Height < 3 { // X pattern needs at least 3x3 space
+		return 0
+	}
+
+	count := 0
+	for row := 1; row < g.Height-1; row++ {
+		for col := 1; col < g.Width-1; col++ {
+			center := grid.Point{X: col, Y: row}
+			if g.At(center) == 'A' && isValidXMAS(g, center) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func findWord(g *grid.Grid[rune], word string) []Position {
+	if g.Height == 0 {
+		return nil
+	}
+
+	searchRunes := []rune(word)
+	var results []Position
+
+	for pos := range g.All() {
+		for _, dir := range grid.Compass {
+			if checkWord(g, searchRunes, pos, dir) {
+				results = append(results, Position{pos, dir})
+			}
+		}
+	}
+	return results
+}
+
+func checkWord(g *grid.Grid[rune], word []rune, start, dir grid.Point) bool {
+	if end := start.Add(dir.Mul(len(word) - 1)); !g.Contains(end) {
+		return false
+	}
+
+	for i := range word {
+		if g.At(start.Add(dir.Mul(i))) != word[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidXMAS(g *grid.Grid[rune], center grid.Point) bool {
+	var d1, d2 bool
+
+	tl, br := g.At(center.Add(grid.UpLeft)), g.At(center.Add(grid.DownRight))
+	d1 = (tl == 'M' && br == 'S') || (tl == 'S' && br == 'M')
+
+	tr, bl := g.At(center.Add(grid.UpRight)), g.At(center.Add(grid.DownLeft))
+	d2 = (tr == 'M' && bl == 'S') || (tr == 'S' && bl == 'M')
+
+	return d1 && d2
+}
+
+func parseInput(r io.Reader) (*grid.Grid[rune], error) {
+	return grid.Parse(r, grid.Runes)
+}
@@ -0,0 +1,56 @@
+package day05
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(5, gen.Generator{Size: "updates", Default: 200, Generate: generate, CheckSize: 20})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	// The pages follow a hidden order and there is a rule for every pair, so
+	// every update has exactly one correct order.
+	order := rng.Perm(90)[:49]
+	for i := range order {
+		order[i] += 10
+	}
+	rank := make(map[int]int, len(order))
+	for i, page := range order {
+		rank[page] = i
+	}
+
+	var rules []string
+	for i, before := range order {
+		for _, after := range order[i+1:] {
+			rules = append(rules, fmt.Sprintf("%d|%d", before, after))
+		}
+	}
+	rng.Shuffle(len(rules), func(i, j int) { rules[i], rules[j] = rules[j], rules[i] })
+
+	var b strings.Builder
+	b.WriteString(strings.Join(rules, "\n"))
+	b.WriteString("\n\n")
+	for range max(size, 1) {
+		pages := slices.Clone(order)
+		rng.Shuffle(len(pages), func(i, j int) { pages[i], pages[j] = pages[j], pages[i] })
+		pages = pages[:5+2*rng.IntN(10)]
+		if rng.IntN(2) == 0 {
+			slices.SortFunc(pages, func(a, b int) int { return rank[a] - rank[b] })
+		}
+		fields := make([]string, len(pages))
+		for i, page := range pages {
+			fields[i] = strconv.Itoa(page)
+		}
+		b.WriteString(strings.Join(fields, ","))
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,74 @@
+package day05
+
+import "context"
+
+// referenceInOrder checks every pair of pages against every rule.
+func referenceInOrder(pages []int, rules []Rule) bool {
+	for i := range pages {
+		for j := i + 1; j < len(pages); j++ {
+			for _, rule := range rules {
+				if pages[i] == rule.afterPage && pages[j] == rule.beforePage {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// referenceOrder repeatedly takes a page that no other remaining page has to
+// come before.
+func referenceOrder(pages []int, rules []Rule) []int {
+	rest := append([]int(nil), pages...)
+	var ordered []int
+	for len(rest) > 0 {
+		next := 0
+		for i, page := range rest {
+			if !referenceMustFollow(page, rest, rules) {
+				next = i
+				break
+			}
+		}
+		ordered = append(ordered, rest[next])
+		rest = append(rest[:next], rest[next+1:]...)
+	}
+	return ordered
+}
+
+func referenceMustFollow(page int, pages []int, rules []Rule) bool {
+	for _, other := range pages {
+		for _, rule := range rules {
+			if rule.beforePage == other && rule.afterPage == page {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func referencePartOne(ctx context.Context, rules []Rule, updates []Update) int {
+	total := 0
+	for _, update := range updates {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if referenceInOrder(update.pages, rules) {
+			total += update.pages[len(update.pages)/2]
+		}
+	}
+	return total
+}
+
+func referencePartTwo(ctx context.Context, rules []Rule, updates []Update) int {
+	total := 0
+	for _, update := range updates {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if !referenceInOrder(update.pages, rules) {
+			ordered := referenceOrder(update.pages, rules)
+			total += ordered[len(ordered)/2]
+		}
+	}
+	return total
+}
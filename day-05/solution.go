@@ -0,0 +1,170 @@
+package day05
+
+import (
+	"context"
+	"io"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Rule struct {
+	beforePage, afterPage int
+}
+
+type Update struct {
+	pages []int
+}
+
+type solution struct {
+	rules     []Rule
+	updates   []Update
+	reference bool
+}
+
+func init() {
+	solver.Register(5, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.rules, s.updates, err = parseInput(r)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(ctx, s.rules, s.updates)
+	}
+	return partOne(ctx, s.rules, s.updates)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.rules, s.updates)
+	}
+	return partTwo(ctx, s.rules, s.updates)
+}
+
+func partOne(ctx context.Context, rules []Rule, updates []Update) int {
+	total := 0
+	for _, update := range updates {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if fixed, changed := validateAndFix(update.pages, rules); !changed {
+			total += fixed[len(fixed)/2]
+		}
+	}
+	return total
+}
+
+func partTwo(ctx context.Context, rules []Rule, updates []Update) int {
+	total := 0
+	for _, update := range updates {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if fixed, changed := validateAndFix(update.pages, rules); changed {
+			total += fixed[len(fixed)/2]
+		}
+	}
+	return total
+}
+
+func validateAndFix(pages []int, rules []Rule) ([]int, bool) {
+	result := make([]int, len(pages))
+	copy(result, pages)
+	changed := false
+
+	for i := 0; i < len(result)-1; i++ {
+		for j := i + 1; j < len(result); j++ {
+			for _, rule := range rules {
+				if result[i] == rule.afterPage && result[j] == rule.beforePage {
+					result[i], result[j] = result[j], result[i]
+					changed = true
+				}
+			}
+		}
+	}
+
+	return result, changed
+}
+
+func parseInput(r io.Reader) ([]Rule, []Update, error) {
+	var rules []Rule
+	var updates []Update
+
+	scanner := parse.NewScanner(r)
+	inUpdates := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if inUpdates {
+				return nil, nil, scanner.Errorf(0, "unexpected blank line in updates")
+			}
+			inUpdates = true
+			continue
+		}
+
+		if !inUpdates {
+			rule, err := parseRule(scanner, line)
+			if err != nil {
+				return nil, nil, err
+			}
+			rules = append(rules, rule)
+			continue
+		}
+
+		update, err := parseUpdate(scanner, line)
+		if err != nil {
+			return nil, nil, err
+		}
+		updates = append(updates, update)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	if !inUpdates {
+		return nil, nil, parse.Errorf(scanner.Line(), 0, "missing blank line between rules and updates")
+	}
+
+	return rules, updates, nil
+}
+
+func parseRule(scanner *parse.Scanner, line string) (Rule, error) {
+	nums := parse.Split(line, "|")
+	if len(nums) != 2 {
+		return Rule{}, scanner.Errorf(0, "invalid rule format: %s", line)
+	}
+
+	before, err := scanner.Int(nums[0])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	after, err := scanner.Int(nums[1])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return Rule{beforePage: before, afterPage: after}, nil
+}
+
+func parseUpdate(scanner *parse.Scanner, line string) (Update, error) {
+	numStrs := parse.Split(line, ",")
+	pages := make([]int, 0, len(numStrs))
+
+	for _, numStr := range numStrs {
+		num, err := scanner.Int(numStr)
+		if err != nil {
+			return Update{}, err
+		}
+		pages = append(pages, num)
+	}
+
+	return Update{pages: pages}, nil
+}
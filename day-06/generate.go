@@ -0,0 +1,53 @@
+package day06
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(6, gen.Generator{Size: "side of the map", Default: 130, Generate: generate, CheckSize: 12})
+}
+
+// generate redraws the map until the guard's patrol leaves it, since part one
+// never ends on a map where the guard walks in a loop.
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 2)
+	for {
+		g := gen.NewGrid(size, size, '.')
+		for _, row := range g {
+			for x := range row {
+				if rng.IntN(12) == 0 {
+					row[x] = '#'
+				}
+			}
+		}
+		// The guard starts away from the edges so the patrol is not over at once.
+		start := grid.Point{X: size/4 + rng.IntN(size/2+1), Y: size/4 + rng.IntN(size/2+1)}
+		g.Set(start, '^')
+		if leaves(g, start) {
+			return g.String(), nil
+		}
+	}
+}
+
+func leaves(g gen.Grid, pos grid.Point) bool {
+	dir := grid.Up
+	seen := make(map[[2]grid.Point]bool)
+	for !seen[[2]grid.Point{pos, dir}] {
+		seen[[2]grid.Point{pos, dir}] = true
+		next := pos.Add(dir)
+		switch {
+		case !g.Contains(next):
+			return true
+		case g.At(next) == '#':
+			dir = dir.RotateRight()
+		default:
+			pos = next
+		}
+	}
+	return false
+}
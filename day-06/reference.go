@@ -0,0 +1,55 @@
+package day06
+
+import (
+	"context"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// referenceWalk follows the guard from start until she leaves the map, which
+// it reports with the positions she stood on, or repeats a position and
+// direction.
+func referenceWalk(m GuardMap, start grid.Point) (map[grid.Point]bool, bool) {
+	pos, dir := start, grid.Up
+	stood := map[grid.Point]bool{pos: true}
+	seen := make(map[[2]grid.Point]bool)
+	for !seen[[2]grid.Point{pos, dir}] {
+		seen[[2]grid.Point{pos, dir}] = true
+		next := pos.Add(dir)
+		switch {
+		case !m.Contains(next):
+			return stood, true
+		case m.At(next):
+			dir = dir.RotateRight()
+		default:
+			pos = next
+			stood[pos] = true
+		}
+	}
+	return stood, false
+}
+
+func referencePartOne(m GuardMap, start grid.Point) int {
+	stood, _ := referenceWalk(m, start)
+	return len(stood)
+}
+
+// referencePartTwo tries an obstruction on every open position but the
+// guard's own.
+func referencePartTwo(ctx context.Context, m GuardMap, start grid.Point) int {
+	count := 0
+	for pos, wall := range m.All() {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if wall || pos == start {
+			continue
+		}
+		m.Set(pos, true)
+		if _, left := referenceWalk(m, start); !left {
+			count++
+		}
+		m.Set(pos, false)
+	}
+	return count
+}
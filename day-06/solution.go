@@ -0,0 +1,240 @@
+package day06
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+const (
+	Wall     = '#'
+	Empty    = '.'
+	StartPos = '^'
+)
+
+type GuardMap struct {
+	*grid.Grid[bool]
+}
+
+func (m GuardMap) isWall(pos grid.Point) bool {
+	return m.At(pos)
+}
+
+func (m GuardMap) withinBounds(pos grid.Point) bool {
+	return m.Contains(pos)
+}
+
+type Guard struct {
+	pos      grid.Point
+	dirIndex int
+	visited  map[grid.Point]bool
+	path     map[grid.Point]bool
+}
+
+func NewGuard(startPos grid.Point) *Guard {
+	return &Guard{
+		pos:      startPos,
+		dirIndex: 0,
+		visited:  make(map[grid.Point]bool),
+		path:     make(map[grid.Point]bool),
+	}
+}
+
+func (g *Guard) currentDirection() grid.Point {
+	return grid.Cardinals[g.dirIndex]
+}
+
+func (g *Guard) turnRight() {
+	g.dirIndex = (g.dirIndex + 1) % len(grid.Cardinals)
+}
+
+func (g *Guard) state() map[string]any {
+	return map[string]any{
+		"x":       g.pos.X,
+		"y":       g.pos.Y,
+		"facing":  string(Facing[g.dirIndex]),
+		"visited": len(g.visited),
+	}
+}
+
+func (g *Guard) move(guardMap GuardMap, trackVisited bool) bool {
+	if !guardMap.withinBounds(g.pos) {
+		return false
+	}
+
+	if trackVisited {
+		g.visited[g.pos] = true
+	} else {
+		g.path[g.pos] = true
+	}
+
+	next := g.pos.Add(g.currentDirection())
+	if !guardMap.withinBounds(next) {
+		return false
+	}
+
+	if guardMap.isWall(next) {
+		g.turnRight()
+	} else {
+		g.pos = next
+	}
+	return true
+}
+
+// Facing maps a direction index to the rune drawn for the guard in traces.
+var Facing = []rune{'^', '>', 'v', '<'}
+
+// render draws the map for the start of a trace.
+func (m GuardMap) render(g *Guard) []string {
+	return trace.Rows(m.Width, m.Height, func(x, y int) rune {
+		p := grid.Point{X: x, Y: y}
+		switch {
+		case p == g.pos:
+			return Facing[g.dirIndex]
+		case m.isWall(p):
+			return Wall
+		}
+		return Empty
+	})
+}
+
+type solution struct {
+	mode      parse.Mode
+	tracer    *trace.Tracer
+	guardMap  GuardMap
+	initPos   grid.Point
+	reference bool
+}
+
+func init() {
+	solver.Register(6, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode)      { s.mode = m }
+func (s *solution) SetReference(on bool)      { s.reference = on }
+func (s *solution) SetTracer(t *trace.Tracer) { s.tracer = t }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.guardMap, s.initPos, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.guardMap, s.initPos)
+	}
+	return partOne(s.guardMap, s.initPos, s.tracer)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.guardMap, s.initPos)
+	}
+	return partTwo(ctx, s.guardMap, s.initPos)
+}
+
+func partOne(guardMap GuardMap, initPos grid.Point, t *trace.Tracer) int {
+	guard := NewGuard(initPos)
+	if t != nil {
+		t.Start(6, "guard walk", guardMap.render(guard), guard.state())
+	}
+
+	for {
+		from, dir := guard.pos, guard.dirIndex
+		if !guard.move(guardMap, true) {
+			break
+		}
+		if t == nil {
+			continue
+		}
+		facing := trace.Cell{X: guard.pos.X, Y: guard.pos.Y, Ch: string(Facing[guard.dirIndex])}
+		if guard.dirIndex != dir {
+			t.Change(fmt.Sprintf("turned to face %s", facing.Ch), []trace.Cell{facing}, guard.state())
+			continue
+		}
+		note := fmt.Sprintf("stepped from %d,%d to %d,%d", from.X, from.Y, guard.pos.X, guard.pos.Y)
+		t.Change(note, []trace.Cell{{X: from.X, Y: from.Y, Ch: "X"}, facing}, guard.state())
+	}
+
+	if t != nil {
+		t.Change("left the map", []trace.Cell{{X: guard.pos.X, Y: guard.pos.Y, Ch: "X"}}, guard.state())
+	}
+	return len(guard.visited)
+}
+
+func partTwo(ctx context.Context, guardMap GuardMap, initPos grid.Point) int {
+	guard := NewGuard(initPos)
+	for guard.move(guardMap, false) {
+	}
+
+	loopCount := 0
+	for pos := range guard.path {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if pos == initPos {
+			continue
+		}
+
+		guardMap.Set(pos, true)
+		if checkLoop(guardMap, initPos) {
+			loopCount++
+		}
+		guardMap.Set(pos, false)
+	}
+	return loopCount
+}
+
+type loopKey struct {
+	pos      grid.Point
+	dirIndex int
+}
+
+func checkLoop(guardMap GuardMap, initPos grid.Point) bool {
+	guard := NewGuard(initPos)
+	visited := make(map[loopKey]struct{})
+
+	for guard.move(guardMap, true) {
+		key := loopKey{guard.pos, guard.dirIndex}
+		if _, exists := visited[key]; exists {
+			return true
+		}
+		visited[key] = struct{}{}
+	}
+	return false
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (GuardMap, grid.Point, error) {
+	var init grid.Point
+	starts := 0
+	g, err := grid.Parse(r, func(p grid.Point, char rune) (bool, error) {
+		switch char {
+		case Wall:
+			return true, nil
+		case StartPos:
+			init = p
+			starts++
+		case Empty:
+		default:
+			if mode == parse.Strict {
+				return false, fmt.Errorf("unexpected character %q", char)
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return GuardMap{}, grid.Point{}, err
+	}
+
+	if mode == parse.Strict && starts != 1 {
+		return GuardMap{}, grid.Point{}, parse.Errorf(0, 0, "found %d guards, want 1", starts)
+	}
+
+	return GuardMap{g}, init, nil
+}
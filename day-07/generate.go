@@ -0,0 +1,50 @@
+package day07
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(7, gen.Generator{Size: "equations", Default: 850, Generate: generate, CheckSize: 20})
+}
+
+// maxTarget keeps every way of combining the numbers of a line well within
+// an int.
+const maxTarget = 1 << 40
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var b strings.Builder
+	for range max(size, 1) {
+		nums := make([]string, 2+rng.IntN(11))
+		target := 0
+		for i := range nums {
+			n := 1 + rng.IntN(999)
+			nums[i] = strconv.Itoa(n)
+
+			next := target + n
+			switch op := rng.IntN(3); {
+			case i == 0:
+				next = n
+			case op == 1 && target*n < maxTarget:
+				next = target * n
+			case op == 2:
+				if c, _ := strconv.Atoi(strconv.Itoa(target) + nums[i]); c < maxTarget {
+					next = c
+				}
+			}
+			target = next
+		}
+		// Nudged targets can almost never be made.
+		if rng.IntN(3) == 0 {
+			target += 1 + rng.IntN(9)
+		}
+		fmt.Fprintf(&b, "%d: %s\n", target, strings.Join(nums, " "))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,59 @@
+package day07
+
+import (
+	"context"
+	"strconv"
+)
+
+// referenceSolvable tries every combination of operators, counting through
+// them as the digits of a number in base len(ops) and evaluating left to
+// right.
+func referenceSolvable(target int, nums []int, ops int) bool {
+	combinations := 1
+	for range len(nums) - 1 {
+		combinations *= ops
+	}
+	for c := range combinations {
+		value := nums[0]
+		for _, n := range nums[1:] {
+			switch c % ops {
+			case 0:
+				value += n
+			case 1:
+				value *= n
+			case 2:
+				value, _ = strconv.Atoi(strconv.Itoa(value) + strconv.Itoa(n))
+			}
+			c /= ops
+		}
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+// referenceSum adds the target of every equation that can be made true, once
+// per equation.
+func referenceSum(ctx context.Context, numMap map[int][][]int, ops int) int {
+	total := 0
+	for target, equations := range numMap {
+		if ctx.Err() != nil {
+			return 0
+		}
+		for _, nums := range equations {
+			if referenceSolvable(target, nums, ops) {
+				total += target
+			}
+		}
+	}
+	return total
+}
+
+func referencePartOne(ctx context.Context, numMap map[int][][]int) int {
+	return referenceSum(ctx, numMap, 2)
+}
+
+func referencePartTwo(ctx context.Context, numMap map[int][][]int) int {
+	return referenceSum(ctx, numMap, 3)
+}
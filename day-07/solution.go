@@ -0,0 +1,155 @@
+package day07
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type solution struct {
+	numMap    map[int][][]int
+	reference bool
+}
+
+func init() {
+	solver.Register(7, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.numMap, err = parseInput(r)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(ctx, s.numMap)
+	}
+	return partOne(ctx, s.numMap)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.numMap)
+	}
+	return partTwo(ctx, s.numMap)
+}
+
+func partOne(ctx context.Context, numMap map[int][][]int) int {
+	return sumOfTargets(ctx, numMap, canFormWithPlusMultiply)
+}
+
+func partTwo(ctx context.Context, numMap map[int][][]int) int {
+	return sumOfTargets(ctx, numMap, canFormWithPlusMultiplyConcat)
+}
+
+func sumOfTargets(ctx context.Context, numMap map[int][][]int, checkFn func(int, [][]int) bool) int {
+	total := 0
+	for target, equations := range numMap {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if checkFn(target, equations) {
+			total += target
+		}
+	}
+	return total
+}
+
+func canFormWithPlusMultiply(target int, equations [][]int) bool {
+	for _, nums := range equations {
+		if canFormTargetOps(nums, target, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func canFormWithPlusMultiplyConcat(target int, equations [][]int) bool {
+	for _, nums := range equations {
+		if canFormTargetOps(nums, target, true) {
+			return true
+		}
+	}
+	return false
+}
+
+func canFormTargetOps(nums []int, target int, allowConcat bool) bool {
+	if len(nums) == 1 {
+		return nums[0] == target
+	}
+
+	a, b := nums[0], nums[1]
+	rest := nums[2:]
+
+	if canFormTargetOps(append([]int{a + b}, rest...), target, allowConcat) {
+		return true
+	}
+
+	if canFormTargetOps(append([]int{a * b}, rest...), target, allowConcat) {
+		return true
+	}
+
+	if allowConcat {
+		concatVal := concatInts(a, b)
+		if canFormTargetOps(append([]int{concatVal}, rest...), target, allowConcat) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func concatInts(a, b int) int {
+	s := strconv.Itoa(a) + strconv.Itoa(b)
+	val, _ := strconv.Atoi(s)
+	return val
+}
+
+func parseInput(r io.Reader) (map[int][][]int, error) {
+	numMap := make(map[int][][]int)
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		parts := parse.Split(scanner.Text(), ":")
+		if len(parts) != 2 {
+			return nil, scanner.Errorf(0, "expected \"target: numbers\"")
+		}
+
+		targetField := parse.Fields(parts[0].Text)
+		if len(targetField) != 1 {
+			return nil, scanner.Errorf(parts[0].Column, "expected a single target")
+		}
+		target, err := scanner.Int(targetField[0])
+		if err != nil {
+			return nil, err
+		}
+
+		numFields := parse.Fields(parts[1].Text)
+		if len(numFields) == 0 {
+			return nil, scanner.Errorf(parts[1].Column, "missing numbers after target")
+		}
+
+		ints := make([]int, 0, len(numFields))
+		for _, f := range numFields {
+			f.Column += parts[1].Column - 1
+			n, err := scanner.Int(f)
+			if err != nil {
+				return nil, err
+			}
+			ints = append(ints, n)
+		}
+
+		numMap[target] = append(numMap[target], ints)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return numMap, nil
+}
@@ -0,0 +1,23 @@
+package day08
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(8, gen.Generator{Size: "side of the map", Default: 50, Generate: generate, CheckSize: 12})
+}
+
+const frequencies = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 1)
+	g := gen.NewGrid(size, size, '.')
+	for range max(size*size/12, 2) {
+		g[rng.IntN(size)][rng.IntN(size)] = frequencies[rng.IntN(len(frequencies))]
+	}
+	return g.String(), nil
+}
@@ -0,0 +1,54 @@
+package day08
+
+import (
+	"context"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// referenceCount tests every position of the map against every pair of
+// antennas with the same frequency.
+func referenceCount(ctx context.Context, lmap LocationMap, bounds grid.Bounds, antinode func(p, a, b grid.Point) bool) int {
+	count := 0
+	for y := range bounds.Height {
+		if ctx.Err() != nil {
+			return 0
+		}
+		for x := range bounds.Width {
+			p := grid.Point{X: x, Y: y}
+			if referenceAny(lmap, p, antinode) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func referenceAny(lmap LocationMap, p grid.Point, antinode func(p, a, b grid.Point) bool) bool {
+	for _, antennas := range lmap {
+		for _, a := range antennas {
+			for _, b := range antennas {
+				if a != b && antinode(p, a, b) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// referencePartOne finds the positions in line with two antennas and twice
+// as far from one as from the other.
+func referencePartOne(ctx context.Context, lmap LocationMap, bounds grid.Bounds) int {
+	return referenceCount(ctx, lmap, bounds, func(p, a, b grid.Point) bool {
+		return p.Sub(a) == p.Sub(b).Mul(2)
+	})
+}
+
+// referencePartTwo finds every position exactly in line with two antennas.
+func referencePartTwo(ctx context.Context, lmap LocationMap, bounds grid.Bounds) int {
+	return referenceCount(ctx, lmap, bounds, func(p, a, b grid.Point) bool {
+		u, v := p.Sub(a), b.Sub(a)
+		return u.X*v.Y == u.Y*v.X
+	})
+}
@@ -0,0 +1,131 @@
+package day08
+
+import (
+	"context"
+	"io"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type LocationMap map[rune][]grid.Point
+
+type solution struct {
+	locations LocationMap
+	bounds    grid.Bounds
+	reference bool
+}
+
+func init() {
+	solver.Register(8, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.locations, s.bounds, err = parseInput(r)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(ctx, s.locations, s.bounds)
+	}
+	return partOne(ctx, s.locations, s.bounds)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.locations, s.bounds)
+	}
+	return partTwo(ctx, s.locations, s.bounds)
+}
+
+func (lm LocationMap) findAntinodes(ctx context.Context, bounds grid.Bounds, extrapolate bool) map[grid.Point]bool {
+	antinodes := make(map[grid.Point]bool)
+
+	for _, coords := range lm {
+		for i := 0; i < len(coords); i++ {
+			if ctx.Err() != nil {
+				return nil
+			}
+			for j := i + 1; j < len(coords); j++ {
+				if extrapolate {
+					antinodes[coords[i]] = true
+					antinodes[coords[j]] = true
+				}
+
+				diff := coords[i].Sub(coords[j])
+				if !extrapolate {
+					processDirection(coords[i], diff, bounds, antinodes, false)
+					processDirection(coords[j], diff.Mul(-1), bounds, antinodes, false)
+					continue
+				}
+
+				// The smallest whole step along the line reaches every
+				// position on it, including those between the antennas.
+				g := gcd(abs(diff.X), abs(diff.Y))
+				step := grid.Point{X: diff.X / g, Y: diff.Y / g}
+				processDirection(coords[i], step, bounds, antinodes, true)
+				processDirection(coords[i], step.Mul(-1), bounds, antinodes, true)
+			}
+		}
+	}
+
+	return antinodes
+}
+
+func processDirection(start grid.Point, diff grid.Point, bounds grid.Bounds, antinodes map[grid.Point]bool, extrapolate bool) {
+	current := start.Add(diff)
+	if !extrapolate {
+		if bounds.Contains(current) {
+			antinodes[current] = true
+		}
+		return
+	}
+
+	for bounds.Contains(current) {
+		antinodes[current] = true
+		current = current.Add(diff)
+	}
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func partOne(ctx context.Context, lmap LocationMap, bounds grid.Bounds) int {
+	return len(lmap.findAntinodes(ctx, bounds, false))
+}
+
+func partTwo(ctx context.Context, lmap LocationMap, bounds grid.Bounds) int {
+	return len(lmap.findAntinodes(ctx, bounds, true))
+}
+
+func parseInput(r io.Reader) (LocationMap, grid.Bounds, error) {
+	g, err := grid.Parse(r, grid.Runes)
+	if err != nil {
+		return nil, grid.Bounds{}, err
+	}
+
+	locations := make(LocationMap)
+	for pos, char := range g.All() {
+		if char == '.' {
+			continue
+		}
+		locations[char] = append(locations[char], pos)
+	}
+
+	return locations, g.Bounds, nil
+}
@@ -0,0 +1,24 @@
+package day09
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(9, gen.Generator{Size: "digits in the disk map", Default: 19999, Generate: generate, CheckSize: 40})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	digits := make([]byte, max(size, 1), max(size, 1)+1)
+	for i := range digits {
+		if i%2 == 0 {
+			digits[i] = byte('1' + rng.IntN(9))
+		} else {
+			digits[i] = byte('0' + rng.IntN(10))
+		}
+	}
+	return string(append(digits, '\n')), nil
+}
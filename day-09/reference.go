@@ -0,0 +1,84 @@
+package day09
+
+import "context"
+
+const referenceFree = -1
+
+func referenceDisk(blocks []*int) []int {
+	disk := make([]int, len(blocks))
+	for i, b := range blocks {
+		disk[i] = referenceFree
+		if b != nil {
+			disk[i] = *b
+		}
+	}
+	return disk
+}
+
+func referenceChecksum(disk []int) int {
+	sum := 0
+	for pos, id := range disk {
+		if id != referenceFree {
+			sum += pos * id
+		}
+	}
+	return sum
+}
+
+// referencePartOne moves the last file block into the first free block
+// until there is no gap left before a file block.
+func referencePartOne(ctx context.Context, blocks []*int) int {
+	disk := referenceDisk(blocks)
+	for ctx.Err() == nil {
+		free, last := -1, -1
+		for i, id := range disk {
+			if id == referenceFree && free < 0 {
+				free = i
+			}
+			if id != referenceFree {
+				last = i
+			}
+		}
+		if free < 0 || free > last {
+			break
+		}
+		disk[free], disk[last] = disk[last], referenceFree
+	}
+	return referenceChecksum(disk)
+}
+
+// referencePartTwo tries each file once, highest ID first, against every
+// span of free blocks to its left.
+func referencePartTwo(ctx context.Context, blocks []*int) int {
+	disk := referenceDisk(blocks)
+	highest := -1
+	for _, id := range disk {
+		highest = max(highest, id)
+	}
+
+	for id := highest; id >= 0 && ctx.Err() == nil; id-- {
+		start, size := -1, 0
+		for i, b := range disk {
+			if b == id {
+				if start < 0 {
+					start = i
+				}
+				size++
+			}
+		}
+
+		for at := 0; at+size <= start; at++ {
+			fits := true
+			for i := at; i < at+size; i++ {
+				fits = fits && disk[i] == referenceFree
+			}
+			if fits {
+				for i := range size {
+					disk[at+i], disk[start+i] = id, referenceFree
+				}
+				break
+			}
+		}
+	}
+	return referenceChecksum(disk)
+}
@@ -0,0 +1,223 @@
+package day09
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Block struct {
+	ID  *int
+	Pos int
+}
+
+type Disk struct {
+	blocks []*int
+}
+
+func NewDisk(blocks []*int) *Disk {
+	return &Disk{blocks: blocks}
+}
+
+func (d *Disk) Clone() *Disk {
+	newBlocks := make([]*int, len(d.blocks))
+	copy(newBlocks, d.blocks)
+	return NewDisk(newBlocks)
+}
+
+func (d *Disk) Checksum() int {
+	sum := 0
+	for pos, block := range d.blocks {
+		if block != nil {
+			sum += pos * (*block)
+		}
+	}
+	return sum
+}
+
+type FileInfo struct {
+	ID        int
+	Positions []int
+	Size      int
+}
+
+func (d *Disk) GetFileInfo() []FileInfo {
+	fileMap := make(map[int][]int)
+	for pos, block := range d.blocks {
+		if block != nil {
+			fileMap[*block] = append(fileMap[*block], pos)
+		}
+	}
+
+	var files []FileInfo
+	for id, positions := range fileMap {
+		files = append(files, FileInfo{
+			ID:        id,
+			Positions: positions,
+			Size:      len(positions),
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ID > files[j].ID
+	})
+
+	return files
+}
+
+// CompactIndividualBlocks moves blocks one at a time from the end of the disk
+// to the leftmost free space, until no free space is left between blocks.
+func (d *Disk) CompactIndividualBlocks(ctx context.Context) error {
+	for i, j := 0, len(d.blocks)-1; i < j; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.blocks[i] != nil {
+			continue
+		}
+		for j > i && d.blocks[j] == nil {
+			j--
+		}
+		d.blocks[i], d.blocks[j] = d.blocks[j], nil
+	}
+	return nil
+}
+
+// CompactWholeFiles moves each file once, highest ID first, to the leftmost
+// free span that fits it.
+func (d *Disk) CompactWholeFiles(ctx context.Context) error {
+	files := d.GetFileInfo()
+
+	// Space freed by a move lies beyond every file still to move, so spans
+	// only shrink and the leftmost one that fits a size only moves right.
+	// from remembers where to resume looking for each size.
+	from := make(map[int]int)
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		gap, end := d.findSuitableGap(file.Size, from[file.Size], file.Positions[0])
+		from[file.Size] = end
+		if gap >= 0 {
+			d.moveFile(file, gap)
+		}
+	}
+	return nil
+}
+
+// findSuitableGap returns the first free span of at least size blocks between
+// start and currentPos, or -1, and where a later search may resume.
+func (d *Disk) findSuitableGap(size, start, currentPos int) (int, int) {
+	gapStart := -1
+	gapSize := 0
+
+	for i := start; i < currentPos; i++ {
+		if d.blocks[i] == nil {
+			if gapStart == -1 {
+				gapStart = i
+			}
+			gapSize++
+			if gapSize >= size {
+				return gapStart, gapStart
+			}
+		} else {
+			gapStart = -1
+			gapSize = 0
+		}
+	}
+	return -1, max(start, currentPos)
+}
+
+func (d *Disk) moveFile(file FileInfo, newPos int) {
+	for _, pos := range file.Positions {
+		d.blocks[pos] = nil
+	}
+
+	for i := 0; i < file.Size; i++ {
+		value := file.ID
+		d.blocks[newPos+i] = &value
+	}
+}
+
+type solution struct {
+	blocks    []*int
+	reference bool
+}
+
+func init() {
+	solver.Register(9, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.blocks, err = parseInput(r)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(ctx, s.blocks)
+	}
+	p1Input := make([]*int, len(s.blocks))
+	copy(p1Input, s.blocks)
+	return partOne(ctx, p1Input)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.blocks)
+	}
+	p2Input := make([]*int, len(s.blocks))
+	copy(p2Input, s.blocks)
+	return partTwo(ctx, p2Input)
+}
+
+func partOne(ctx context.Context, blocks []*int) int {
+	disk := NewDisk(blocks)
+	if err := disk.CompactIndividualBlocks(ctx); err != nil {
+		return 0
+	}
+	return disk.Checksum()
+}
+
+func partTwo(ctx context.Context, blocks []*int) int {
+	disk := NewDisk(blocks)
+	if err := disk.CompactWholeFiles(ctx); err != nil {
+		return 0
+	}
+	return disk.Checksum()
+}
+
+func parseInput(r io.Reader) ([]*int, error) {
+	var blocks []*int
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		for i := 0; i < len(line); i++ {
+			if line[i] < '0' || line[i] > '9' {
+				return nil, scanner.Errorf(i+1, "expected a digit, got %q", line[i])
+			}
+			parsedChar := int(line[i] - '0')
+
+			var block *int
+
+			if i%2 == 0 {
+				adjusted := i / 2
+				block = &adjusted
+			}
+
+			for j := 0; j < parsedChar; j++ {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	return blocks, scanner.Err()
+}
@@ -0,0 +1,57 @@
+package day10
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(10, gen.Generator{Size: "side of the map", Default: 50, Generate: generate, CheckSize: 10})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 1)
+
+	// Heights fall away from scattered peaks so that trails run down every
+	// slope, as in the real maps.
+	dist := make([][]int, size)
+	for y := range dist {
+		dist[y] = make([]int, size)
+		for x := range dist[y] {
+			dist[y][x] = -1
+		}
+	}
+	var queue []grid.Point
+	for range size*size/80 + 1 {
+		p := grid.Point{X: rng.IntN(size), Y: rng.IntN(size)}
+		if dist[p.Y][p.X] < 0 {
+			dist[p.Y][p.X] = 0
+			queue = append(queue, p)
+		}
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range cur.Neighbours4() {
+			if next.X >= 0 && next.Y >= 0 && next.X < size && next.Y < size && dist[next.Y][next.X] < 0 {
+				dist[next.Y][next.X] = dist[cur.Y][cur.X] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	g := gen.NewGrid(size, size, '0')
+	for y, row := range g {
+		for x := range row {
+			if d := dist[y][x]; d <= 9 && rng.IntN(10) > 0 {
+				row[x] = byte('9' - d)
+			} else {
+				row[x] = byte('0' + rng.IntN(10))
+			}
+		}
+	}
+	return g.String(), nil
+}
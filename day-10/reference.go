@@ -0,0 +1,53 @@
+package day10
+
+import (
+	"context"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// referenceTrails lists the end of every hiking trail from start, once for
+// each distinct trail. Heights rise by one each step, so no trail can visit
+// a position twice.
+func referenceTrails(g *grid.Grid[int], start grid.Point) []grid.Point {
+	if g.At(start) == 9 {
+		return []grid.Point{start}
+	}
+	var ends []grid.Point
+	for _, next := range start.Neighbours4() {
+		if g.Contains(next) && g.At(start) >= 0 && g.At(next) == g.At(start)+1 {
+			ends = append(ends, referenceTrails(g, next)...)
+		}
+	}
+	return ends
+}
+
+func referencePartOne(ctx context.Context, h *HikingTrails) int {
+	total := 0
+	for pos, height := range h.grid.All() {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if height == 0 {
+			nines := make(map[grid.Point]bool)
+			for _, end := range referenceTrails(h.grid, pos) {
+				nines[end] = true
+			}
+			total += len(nines)
+		}
+	}
+	return total
+}
+
+func referencePartTwo(ctx context.Context, h *HikingTrails) int {
+	total := 0
+	for pos, height := range h.grid.All() {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if height == 0 {
+			total += len(referenceTrails(h.grid, pos))
+		}
+	}
+	return total
+}
@@ -0,0 +1,156 @@
+package day10
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+const (
+	MinHeight = 0
+	MaxHeight = 9
+	// Impassable marks tiles left out of the smaller examples.
+	Impassable = '.'
+)
+
+type HikingTrails struct {
+	grid *grid.Grid[int]
+}
+
+func (h *HikingTrails) findTrailheadScores(ctx context.Context, countPaths bool) int {
+	totalScore := 0
+	for pos, height := range h.grid.All() {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if height == MinHeight {
+			if countPaths {
+				totalScore += h.countTrailPaths(pos)
+			} else {
+				totalScore += len(h.findReachableNines(pos))
+			}
+		}
+	}
+	return totalScore
+}
+
+func (h *HikingTrails) countTrailPaths(start grid.Point) int {
+	visited := make(map[grid.Point]bool)
+	return h.exploreTrailPaths(start, visited)
+}
+
+func (h *HikingTrails) findReachableNines(start grid.Point) map[grid.Point]bool {
+	reachableNines := make(map[grid.Point]bool)
+	visited := make(map[grid.Point]bool)
+	h.exploreTrails(start, visited, reachableNines)
+	return reachableNines
+}
+
+func (h *HikingTrails) exploreTrails(current grid.Point, visited map[grid.Point]bool, nines map[grid.Point]bool) {
+	currentHeight := h.grid.At(current)
+	visited[current] = true
+	defer func() { visited[current] = false }()
+
+	for _, next := range current.Neighbours4() {
+		nextHeight, exists := h.grid.Get(next)
+		if !exists || visited[next] {
+			continue
+		}
+
+		if nextHeight == MaxHeight && currentHeight == MaxHeight-1 {
+			nines[next] = true
+			continue
+		}
+
+		if nextHeight == currentHeight+1 {
+			h.exploreTrails(next, visited, nines)
+		}
+	}
+}
+
+func (h *HikingTrails) exploreTrailPaths(current grid.Point, visited map[grid.Point]bool) int {
+	currentHeight := h.grid.At(current)
+	if currentHeight == MaxHeight {
+		return 1
+	}
+
+	visited[current] = true
+	defer func() { visited[current] = false }()
+
+	pathCount := 0
+	for _, next := range current.Neighbours4() {
+		nextHeight, exists := h.grid.Get(next)
+		if !exists || visited[next] {
+			continue
+		}
+
+		if nextHeight == currentHeight+1 {
+			pathCount += h.exploreTrailPaths(next, visited)
+		}
+	}
+	return pathCount
+}
+
+type solution struct {
+	mode      parse.Mode
+	trails    *HikingTrails
+	reference bool
+}
+
+func init() {
+	solver.Register(10, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.trails, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(ctx, s.trails)
+	}
+	return partOne(ctx, s.trails)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.trails)
+	}
+	return partTwo(ctx, s.trails)
+}
+
+func partOne(ctx context.Context, h *HikingTrails) int {
+	return h.findTrailheadScores(ctx, false)
+}
+
+func partTwo(ctx context.Context, h *HikingTrails) int {
+	return h.findTrailheadScores(ctx, true)
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*HikingTrails, error) {
+	g, err := grid.Parse(r, func(_ grid.Point, char rune) (int, error) {
+		switch {
+		case char >= '0' && char <= '9':
+			return int(char - '0'), nil
+		case char == Impassable:
+			return -1, nil
+		case mode == parse.Strict:
+			return 0, fmt.Errorf("unexpected character %q", char)
+		}
+		return int(char - '0'), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &HikingTrails{grid: g}, nil
+}
@@ -0,0 +1,26 @@
+package day11
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(11, gen.Generator{Size: "stones", Default: 8, Generate: generate, CheckSize: 3, CheckParams: solver.Params{"part2-blinks": 30}})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	stones := make([]string, max(size, 1))
+	for i := range stones {
+		limit := 1
+		for range 1 + rng.IntN(7) {
+			limit *= 10
+		}
+		stones[i] = strconv.Itoa(rng.IntN(limit))
+	}
+	return strings.Join(stones, " ") + "\n", nil
+}
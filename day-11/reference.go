@@ -0,0 +1,27 @@
+package day11
+
+import "strconv"
+
+// referenceBlink keeps every stone in a list and applies the rules to it
+// blink by blink.
+func referenceBlink(stones []Stone, blinks int) uint64 {
+	line := append([]Stone(nil), stones...)
+	for range blinks {
+		var next []Stone
+		for _, s := range line {
+			digits := strconv.FormatUint(uint64(s), 10)
+			switch {
+			case s == 0:
+				next = append(next, 1)
+			case len(digits)%2 == 0:
+				left, _ := strconv.ParseUint(digits[:len(digits)/2], 10, 64)
+				right, _ := strconv.ParseUint(digits[len(digits)/2:], 10, 64)
+				next = append(next, Stone(left), Stone(right))
+			default:
+				next = append(next, s*2024)
+			}
+		}
+		line = next
+	}
+	return uint64(len(line))
+}
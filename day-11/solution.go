@@ -0,0 +1,148 @@
+package day11
+
+import (
+	"context"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/reecepm/aoc-2024/memo"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Stone uint64
+
+type StoneCache struct {
+	cache *memo.Cache[cacheKey, uint64]
+}
+
+type cacheKey struct {
+	stone Stone
+	depth uint8
+}
+
+func NewStoneCache() *StoneCache {
+	return &StoneCache{
+		cache: memo.New[cacheKey, uint64](memo.Options{Name: "day11/stones"}),
+	}
+}
+
+func (s *StoneCache) process(stone Stone, depth uint8) uint64 {
+	if depth == 0 {
+		return 1
+	}
+
+	key := cacheKey{stone, depth}
+	if count, exists := s.cache.Get(key); exists {
+		return count
+	}
+
+	var count uint64
+	for _, next := range stone.getNextStones() {
+		count += s.process(next, depth-1)
+	}
+
+	s.cache.Put(key, count)
+	return count
+}
+
+func (s Stone) getNextStones() []Stone {
+	if s == 0 {
+		return []Stone{1}
+	}
+
+	digits := s.countDigits()
+	if digits%2 == 0 {
+		exp := uint64(math.Pow10(int(digits) / 2))
+		return []Stone{Stone(uint64(s) / exp), Stone(uint64(s) % exp)}
+	}
+
+	return []Stone{s * 2024}
+}
+
+func (s Stone) countDigits() uint8 {
+	if s == 0 {
+		return 1
+	}
+	return uint8(math.Floor(math.Log10(float64(s)))) + 1
+}
+
+// maxBlinks keeps the counts within uint64: after 90 blinks no stone has
+// grown past 2^56 stones, so a row of 256 cannot overflow.
+const maxBlinks = 90
+
+var params = []solver.Param{
+	{Name: "part1-blinks", Usage: "times the stones blink in part one", Default: 25, Max: maxBlinks},
+	{Name: "part2-blinks", Usage: "times the stones blink in part two", Default: 75, Max: maxBlinks},
+}
+
+type solution struct {
+	stones    []Stone
+	params    solver.Params
+	reference bool
+}
+
+func init() {
+	solver.Register(11, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.stones, err = parseInput(r)
+	return err
+}
+
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referenceBlink(s.stones, s.params["part1-blinks"])
+	}
+	return partOne(s.stones, uint8(s.params["part1-blinks"]))
+}
+
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referenceBlink(s.stones, s.params["part2-blinks"])
+	}
+	return partTwo(s.stones, uint8(s.params["part2-blinks"]))
+}
+
+func partOne(stones []Stone, blinks uint8) uint64 {
+	return processStones(stones, blinks)
+}
+
+func partTwo(stones []Stone, blinks uint8) uint64 {
+	return processStones(stones, blinks)
+}
+
+func processStones(stones []Stone, depth uint8) uint64 {
+	cache := NewStoneCache()
+	var count uint64
+
+	for _, stone := range stones {
+		count += cache.process(stone, depth)
+	}
+	return count
+}
+
+func parseInput(r io.Reader) ([]Stone, error) {
+	var stones []Stone
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		for _, f := range parse.Fields(scanner.Text()) {
+			num, err := strconv.ParseUint(f.Text, 10, 64)
+			if err != nil {
+				return nil, scanner.Errorf(f.Column, "invalid stone %q", f.Text)
+			}
+			stones = append(stones, Stone(num))
+		}
+	}
+
+	return stones, scanner.Err()
+}
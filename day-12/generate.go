@@ -0,0 +1,42 @@
+package day12
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(12, gen.Generator{Size: "side of the garden", Default: 140, Generate: generate, CheckSize: 10})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 1)
+
+	// Regions grow outwards from random seeds until they meet, and the same
+	// plant often ends up in several separate regions.
+	g := gen.NewGrid(size, size, 0)
+	var queue []grid.Point
+	for range size*size/20 + 1 {
+		p := grid.Point{X: rng.IntN(size), Y: rng.IntN(size)}
+		if g.At(p) == 0 {
+			g.Set(p, byte('A'+rng.IntN(26)))
+			queue = append(queue, p)
+		}
+	}
+	for len(queue) > 0 {
+		i := rng.IntN(len(queue))
+		cur := queue[i]
+		queue[i] = queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		for _, next := range cur.Neighbours4() {
+			if g.Contains(next) && g.At(next) == 0 {
+				g.Set(next, g.At(cur))
+				queue = append(queue, next)
+			}
+		}
+	}
+	return g.String(), nil
+}
@@ -0,0 +1,61 @@
+package day12
+
+import (
+	"context"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// referenceRegions labels each plot with the smallest index of a plot in
+// its region, spreading labels between neighbours until nothing changes.
+func referenceRegions(ctx context.Context, g *Garden) map[grid.Point]int {
+	label := make(map[grid.Point]int)
+	for pos := range g.All() {
+		label[pos] = pos.Y*g.Width + pos.X
+	}
+	for changed := true; changed && ctx.Err() == nil; {
+		changed = false
+		for pos, plant := range g.All() {
+			for _, next := range pos.Neighbours4() {
+				if p, ok := g.Get(next); ok && p == plant && label[next] < label[pos] {
+					label[pos] = label[next]
+					changed = true
+				}
+			}
+		}
+	}
+	return label
+}
+
+// referencePrice prices every region by its area and either its fence
+// length or its number of sides. A piece of fence starts a new side unless
+// the plot before it along the fence has the same piece.
+func referencePrice(ctx context.Context, g *Garden, bySides bool) int {
+	label := referenceRegions(ctx, g)
+	area := make(map[int]int)
+	fence := make(map[int]int)
+	fenced := func(pos, dir grid.Point) bool {
+		next := pos.Add(dir)
+		return !g.Contains(next) || label[next] != label[pos]
+	}
+
+	for pos := range g.All() {
+		area[label[pos]]++
+		for _, dir := range grid.Cardinals {
+			if !fenced(pos, dir) {
+				continue
+			}
+			before := pos.Add(dir.RotateLeft())
+			if bySides && g.Contains(before) && label[before] == label[pos] && fenced(before, dir) {
+				continue
+			}
+			fence[label[pos]]++
+		}
+	}
+
+	total := 0
+	for l, a := range area {
+		total += a * fence[l]
+	}
+	return total
+}
@@ -0,0 +1,179 @@
+package day12
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Garden struct {
+	*grid.Grid[rune]
+}
+
+type Region struct {
+	coords map[grid.Point]bool
+	area   int
+}
+
+func (r *Region) contains(c grid.Point) bool {
+	return r.coords[c]
+}
+
+func (r *Region) add(c grid.Point) {
+	r.coords[c] = true
+	r.area++
+}
+
+type OrientedEdge struct {
+	pos grid.Point
+	dir grid.Point
+}
+
+func (g *Garden) findAllRegions(ctx context.Context) []Region {
+	visited := grid.New[bool](g.Width, g.Height)
+
+	var regions []Region
+	for coord, plantType := range g.All() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !visited.At(coord) {
+			region := g.floodFill(coord, plantType, visited)
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+func (g *Garden) floodFill(start grid.Point, plantType rune, visited *grid.Grid[bool]) Region {
+	region := Region{coords: make(map[grid.Point]bool)}
+	queue := []grid.Point{start}
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		if visited.At(curr) {
+			continue
+		}
+
+		visited.Set(curr, true)
+		region.add(curr)
+
+		for _, next := range curr.Neighbours4() {
+			if plant, ok := g.Get(next); ok && plant == plantType && !visited.At(next) {
+				queue = append(queue, next)
+			}
+		}
+	}
+	return region
+}
+
+func (g *Garden) calcEdges(r Region) int {
+	edges := 0
+	for coord := range r.coords {
+		exposed := 4
+		for _, next := range coord.Neighbours4() {
+			if r.contains(next) {
+				exposed--
+			}
+		}
+		edges += exposed
+	}
+	return edges
+}
+
+func (g *Garden) calcSides(r Region) int {
+	processed := make(map[OrientedEdge]bool)
+	sides := 0
+
+	for coord := range r.coords {
+		for _, dir := range grid.Cardinals {
+			next := coord.Add(dir)
+			if !r.contains(next) {
+				edge := OrientedEdge{coord, dir}
+				if !processed[edge] {
+					sides++
+
+					for p1, p2 := coord, next; r.contains(p1) && !r.contains(p2); {
+						processed[OrientedEdge{p1, dir}] = true
+						p1 = p1.Add(dir.RotateLeft())
+						p2 = p2.Add(dir.RotateLeft())
+					}
+
+					for p1, p2 := coord, next; r.contains(p1) && !r.contains(p2); {
+						processed[OrientedEdge{p1, dir}] = true
+						p1 = p1.Add(dir.RotateRight())
+						p2 = p2.Add(dir.RotateRight())
+					}
+				}
+			}
+		}
+	}
+	return sides
+}
+
+type solution struct {
+	mode      parse.Mode
+	garden    *Garden
+	reference bool
+}
+
+func init() {
+	solver.Register(12, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.garden, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePrice(ctx, s.garden, false)
+	}
+	return partOne(ctx, s.garden)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePrice(ctx, s.garden, true)
+	}
+	return partTwo(ctx, s.garden)
+}
+
+func partOne(ctx context.Context, g *Garden) int {
+	total := 0
+	for _, region := range g.findAllRegions(ctx) {
+		total += region.area * g.calcEdges(region)
+	}
+	return total
+}
+
+func partTwo(ctx context.Context, g *Garden) int {
+	total := 0
+	for _, region := range g.findAllRegions(ctx) {
+		total += region.area * g.calcSides(region)
+	}
+	return total
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*Garden, error) {
+	g, err := grid.Parse(r, grid.Runes)
+	if err != nil {
+		return nil, fmt.Errorf("scanning input: %w", err)
+	}
+	if mode == parse.Strict && g.Height == 0 {
+		return nil, &parse.Error{Err: parse.ErrEmpty}
+	}
+
+	return &Garden{g}, nil
+}
@@ -0,0 +1,33 @@
+package day13
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(13, gen.Generator{Size: "claw machines", Default: 320, Generate: generate, CheckSize: 20})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var machines []string
+	for range max(size, 1) {
+		var ax, ay, bx, by int
+		for ax*by == ay*bx {
+			ax, ay, bx, by = 10+rng.IntN(90), 10+rng.IntN(90), 10+rng.IntN(90), 10+rng.IntN(90)
+		}
+		// Half the prizes can be won, some of them only with more than the
+		// hundred presses part one allows.
+		px, py := 1000+rng.IntN(19000), 1000+rng.IntN(19000)
+		if rng.IntN(2) == 0 {
+			a, b := rng.IntN(121), rng.IntN(121)
+			px, py = a*ax+b*bx, a*ay+b*by
+		}
+		machines = append(machines, fmt.Sprintf("Button A: X+%d, Y+%d\nButton B: X+%d, Y+%d\nPrize: X=%d, Y=%d\n", ax, ay, bx, by, px, py))
+	}
+	return strings.Join(machines, "\n"), nil
+}
@@ -0,0 +1,45 @@
+package day13
+
+// referencePartOne tries every number of presses up to a hundred on each
+// button.
+func referencePartOne(arcade *Arcade) int {
+	total := 0
+	for _, m := range arcade.machines {
+		best := -1
+		for a := int64(0); a <= 100; a++ {
+			for b := int64(0); b <= 100; b++ {
+				if a*m.ButtonA.x+b*m.ButtonB.x == m.Prize.x && a*m.ButtonA.y+b*m.ButtonB.y == m.Prize.y {
+					if cost := int(3*a + b); best < 0 || cost < best {
+						best = cost
+					}
+				}
+			}
+		}
+		if best >= 0 {
+			total += best
+		}
+	}
+	return total
+}
+
+// referencePartTwo solves each machine's two equations in exact integers.
+// The prizes are too far away to search, so machines whose buttons move in
+// the same direction, which have no single answer, are left out.
+func referencePartTwo(arcade *Arcade) int {
+	const offset = 10000000000000
+	total := 0
+	for _, m := range arcade.machines {
+		px, py := m.Prize.x+offset, m.Prize.y+offset
+		det := m.ButtonA.x*m.ButtonB.y - m.ButtonB.x*m.ButtonA.y
+		if det == 0 {
+			continue
+		}
+		an := px*m.ButtonB.y - py*m.ButtonB.x
+		bn := m.ButtonA.x*py - m.ButtonA.y*px
+		if an%det != 0 || bn%det != 0 || an/det < 0 || bn/det < 0 {
+			continue
+		}
+		total += int(3*(an/det) + bn/det)
+	}
+	return total
+}
@@ -0,0 +1,221 @@
+package day13
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Coordinate struct {
+	x, y int64
+}
+
+type Button Coordinate
+
+type Prize Coordinate
+
+type ClawMachine struct {
+	ButtonA Button
+	ButtonB Button
+	Prize   Prize
+}
+
+type Arcade struct {
+	machines []ClawMachine
+}
+
+func NewArcade() *Arcade {
+	return &Arcade{
+		machines: make([]ClawMachine, 0),
+	}
+}
+
+func (a *Arcade) AddMachine(m ClawMachine) {
+	a.machines = append(a.machines, m)
+}
+
+// SolvePuzzle moves every prize by offset and adds up the fewest tokens that
+// win each prize that can be won. A positive maxPresses limits how often
+// each button may be pressed.
+func (a *Arcade) SolvePuzzle(offset int64, maxPresses int64) int {
+	machines := make([]ClawMachine, len(a.machines))
+	copy(machines, a.machines)
+
+	if offset > 0 {
+		for i := range machines {
+			machines[i].Prize.x += offset
+			machines[i].Prize.y += offset
+		}
+	}
+
+	total := 0
+	for _, m := range machines {
+		if !solutionExists(m.ButtonA, m.ButtonB, m.Prize) {
+			continue
+		}
+
+		minTokens := findMinTokens(m, maxPresses)
+		if minTokens != -1 {
+			total += minTokens
+		}
+	}
+	return total
+}
+
+func findMinTokens(m ClawMachine, maxPresses int64) int {
+	det := float64(m.ButtonA.x*m.ButtonB.y - m.ButtonB.x*m.ButtonA.y)
+	if det == 0 {
+		return -1
+	}
+
+	a := float64(m.Prize.x*m.ButtonB.y-m.Prize.y*m.ButtonB.x) / det
+	b := float64(m.ButtonA.x*m.Prize.y-m.ButtonA.y*m.Prize.x) / det
+
+	if a != float64(int64(a)) || b != float64(int64(b)) || a < 0 || b < 0 {
+		return -1
+	}
+	if maxPresses > 0 && (int64(a) > maxPresses || int64(b) > maxPresses) {
+		return -1
+	}
+
+	return int(3*int64(a) + int64(b))
+}
+
+func solutionExists(buttonA Button, buttonB Button, prize Prize) bool {
+	gcdX := GCD(buttonA.x, buttonB.x)
+	gcdY := GCD(buttonA.y, buttonB.y)
+
+	if gcdX == 0 || gcdY == 0 {
+		return false
+	}
+
+	return prize.x%gcdX == 0 && prize.y%gcdY == 0
+}
+
+func GCD(a, b int64) int64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+
+	for b != 0 {
+		t := b
+		b = a % b
+		a = t
+	}
+	return a
+}
+
+type solution struct {
+	mode      parse.Mode
+	arcade    *Arcade
+	reference bool
+}
+
+func init() {
+	solver.Register(13, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.arcade, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.arcade)
+	}
+	return partOne(s.arcade)
+}
+
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referencePartTwo(s.arcade)
+	}
+	return partTwo(s.arcade)
+}
+
+func partOne(arcade *Arcade) int {
+	return arcade.SolvePuzzle(0, 100)
+}
+
+func partTwo(arcade *Arcade) int {
+	return arcade.SolvePuzzle(10000000000000, 0)
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*Arcade, error) {
+	arcade := NewArcade()
+	var currentMachine ClawMachine
+	var seenA, seenB bool
+
+	buttonPattern := regexp.MustCompile(`Button ([AB]): X\+(\d+), Y\+(\d+)`)
+	prizePattern := regexp.MustCompile(`Prize: X=(\d+), Y=(\d+)`)
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		if buttonMatch := parse.Match(buttonPattern, line); buttonMatch != nil {
+			if mode == parse.Strict && buttonMatch[0].Text != line {
+				return nil, scanner.Errorf(0, "malformed button line")
+			}
+			x, errX := scanner.Int64(buttonMatch[2])
+			y, errY := scanner.Int64(buttonMatch[3])
+			if err := errors.Join(errX, errY); err != nil && mode == parse.Strict {
+				return nil, err
+			}
+
+			if buttonMatch[1].Text == "A" {
+				currentMachine.ButtonA = Button{x: x, y: y}
+				seenA = true
+			} else {
+				currentMachine.ButtonB = Button{x: x, y: y}
+				seenB = true
+			}
+		} else if prizeMatch := parse.Match(prizePattern, line); prizeMatch != nil {
+			if mode == parse.Strict {
+				if prizeMatch[0].Text != line {
+					return nil, scanner.Errorf(0, "malformed prize line")
+				}
+				if !seenA || !seenB {
+					return nil, scanner.Errorf(0, "prize before both buttons are defined")
+				}
+			}
+			x, errX := scanner.Int64(prizeMatch[1])
+			y, errY := scanner.Int64(prizeMatch[2])
+			if err := errors.Join(errX, errY); err != nil && mode == parse.Strict {
+				return nil, err
+			}
+			currentMachine.Prize = Prize{x: x, y: y}
+
+			arcade.AddMachine(currentMachine)
+			currentMachine = ClawMachine{}
+			seenA, seenB = false, false
+		} else if mode == parse.Strict {
+			return nil, scanner.Errorf(0, "expected a button or prize, got %q", line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning input: %w", err)
+	}
+	if mode == parse.Strict && (seenA || seenB) {
+		return nil, parse.Errorf(scanner.Line(), 0, "machine has no prize")
+	}
+
+	return arcade, nil
+}
@@ -0,0 +1,24 @@
+package day14
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(14, gen.Generator{Size: "robots", Default: 500, Generate: generate, CheckSize: 200, CheckParams: solver.Params{"search-limit": 100}})
+}
+
+// generate scatters robots across a room of the default size. They never
+// form a Christmas tree, so part two searches to its limit.
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var b strings.Builder
+	for range max(size, 1) {
+		fmt.Fprintf(&b, "p=%d,%d v=%d,%d\n", rng.IntN(101), rng.IntN(103), rng.IntN(201)-100, rng.IntN(201)-100)
+	}
+	return b.String(), nil
+}
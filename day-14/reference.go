@@ -0,0 +1,61 @@
+package day14
+
+import "context"
+
+// referenceStep moves every robot one second, wrapping around the room.
+func referenceStep(s *RobotSwarm, positions []Coordinate) {
+	for i, r := range s.Robots {
+		positions[i].x = ((positions[i].x+r.Velocity.x)%s.Width + s.Width) % s.Width
+		positions[i].y = ((positions[i].y+r.Velocity.y)%s.Height + s.Height) % s.Height
+	}
+}
+
+func referenceStart(s *RobotSwarm) []Coordinate {
+	positions := make([]Coordinate, len(s.Robots))
+	for i, r := range s.Robots {
+		positions[i] = r.Position
+	}
+	return positions
+}
+
+// referencePartOne moves the robots a second at a time and then counts them
+// quadrant by quadrant.
+func referencePartOne(s *RobotSwarm, seconds int64) int {
+	positions := referenceStart(s)
+	for range seconds {
+		referenceStep(s, positions)
+	}
+
+	var quadrants [4]int
+	midX, midY := s.Width/2, s.Height/2
+	for _, p := range positions {
+		switch {
+		case p.x < midX && p.y < midY:
+			quadrants[0]++
+		case p.x > midX && p.y < midY:
+			quadrants[1]++
+		case p.x < midX && p.y > midY:
+			quadrants[2]++
+		case p.x > midX && p.y > midY:
+			quadrants[3]++
+		}
+	}
+	return quadrants[0] * quadrants[1] * quadrants[2] * quadrants[3]
+}
+
+// referencePartTwo looks for the first second at which no two robots share a
+// tile, which is when they draw the tree.
+func referencePartTwo(ctx context.Context, s *RobotSwarm, limit int64) int64 {
+	positions := referenceStart(s)
+	for second := int64(1); second <= limit && ctx.Err() == nil; second++ {
+		referenceStep(s, positions)
+		taken := make(map[Coordinate]bool)
+		for _, p := range positions {
+			taken[p] = true
+		}
+		if len(taken) == len(positions) {
+			return second
+		}
+	}
+	return 0
+}
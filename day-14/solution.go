@@ -0,0 +1,335 @@
+package day14
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/reecepm/aoc-2024/logging"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+type Coordinate struct {
+	x, y int64
+}
+
+// wrap returns a modulo n in 0..n-1.
+func wrap(a, n int64) int64 {
+	return (a%n + n) % n
+}
+
+type Robot struct {
+	Position Coordinate
+	Velocity Coordinate
+}
+
+type RobotSwarm struct {
+	Width   int64
+	Height  int64
+	Robots  []Robot
+	Display *Display
+}
+
+func NewRobotSwarm(width, height int64) *RobotSwarm {
+	return &RobotSwarm{
+		Width:   width,
+		Height:  height,
+		Display: NewDisplay(),
+	}
+}
+
+func (s *RobotSwarm) AddRobot(r Robot) {
+	s.Robots = append(s.Robots, r)
+}
+
+// at returns where a robot is after t seconds. Reducing its velocity and t
+// modulo the room first keeps the product within int64 however large t is.
+func (s *RobotSwarm) at(r Robot, t int64) Coordinate {
+	return Coordinate{
+		x: wrap(r.Position.x+wrap(r.Velocity.x, s.Width)*(t%s.Width), s.Width),
+		y: wrap(r.Position.y+wrap(r.Velocity.y, s.Height)*(t%s.Height), s.Height),
+	}
+}
+
+func (s *RobotSwarm) calculateQuadrants(time int64) [4]int {
+	midX, midY := s.Width/2, s.Height/2
+	quadrants := [4]int{}
+
+	for _, robot := range s.Robots {
+		pos := s.at(robot, time)
+		x, y := pos.x, pos.y
+
+		if x == midX || y == midY {
+			continue
+		}
+
+		if x > midX {
+			if y > midY {
+				quadrants[0]++
+			} else {
+				quadrants[1]++
+			}
+		} else {
+			if y > midY {
+				quadrants[2]++
+			} else {
+				quadrants[3]++
+			}
+		}
+	}
+	return quadrants
+}
+
+func (s *RobotSwarm) getRobotPositions(second int64) map[Coordinate][]Coordinate {
+	positions := make(map[Coordinate][]Coordinate, len(s.Robots))
+	for _, robot := range s.Robots {
+		newPos := s.at(robot, second)
+		positions[newPos] = append(positions[newPos], robot.Velocity)
+	}
+	return positions
+}
+
+func (s *RobotSwarm) safetyFactor(second int64) int {
+	result := 1
+	for _, count := range s.calculateQuadrants(second) {
+		result *= count
+	}
+	return result
+}
+
+// render draws the room at the given second, counting the robots on each tile
+// like the puzzle's examples do.
+func (s *RobotSwarm) render(second int64) []string {
+	positions := s.getRobotPositions(second)
+	return trace.Rows(int(s.Width), int(s.Height), func(x, y int) rune {
+		switch n := len(positions[Coordinate{int64(x), int64(y)}]); {
+		case n == 0:
+			return '.'
+		case n > 9:
+			return '+'
+		default:
+			return rune('0' + n)
+		}
+	})
+}
+
+// record traces the swarm one second at a time. If part two finds the
+// Christmas tree, it adds that frame as the final step.
+func (s *RobotSwarm) record(t *trace.Tracer, seconds int64) {
+	t.Start(14, "robot swarm", s.render(0), map[string]any{"second": 0, "robots": len(s.Robots)})
+	for second := int64(1); second <= seconds; second++ {
+		t.Step(fmt.Sprintf("second %d", second), s.render(second),
+			map[string]any{"second": second, "safety": s.safetyFactor(second)})
+	}
+}
+
+type Display struct {
+	tree     *color.Color
+	box      *color.Color
+	snow     *color.Color
+	quarters []rune
+}
+
+func NewDisplay() *Display {
+	return &Display{
+		tree: color.New(color.FgGreen),
+		box:  color.New(color.FgRed),
+		snow: color.New(color.FgWhite),
+		quarters: []rune{
+			' ', // empty
+			'▘', // tl
+			'▝', // tr
+			'▀', // top full
+			'▖', // bl
+			'▌', // left half
+			'▞', // diag tr + bl
+			'▛', // tl, tr, bl
+			'▗', // br
+			'▚', // tl, br
+			'▐', // right half
+			'▜', // tr, tl, br
+			'▄', // bottom full
+			'▙', // tl, bl, br
+			'▟', // tr, bl, br
+			'█', // full
+		},
+	}
+}
+
+// draw renders the room two tiles to a character, coloured by how many of
+// the four tiles hold robots.
+func (d *Display) draw(positions map[Coordinate][]Coordinate, width, height int64) string {
+	var b strings.Builder
+	for y := int64(0); y < height; y += 2 {
+		for x := int64(0); x < width; x += 2 {
+			var bits, nBits int
+			for dy := int64(0); dy < 2; dy++ {
+				for dx := int64(0); dx < 2; dx++ {
+					p := Coordinate{x: x + dx, y: y + dy}
+					if len(positions[p]) > 0 {
+						bits |= 1 << (dy*2 + dx)
+						nBits++
+					}
+				}
+			}
+
+			o := d.snow
+			switch nBits {
+			case 2:
+				o = d.box
+			case 3, 4:
+				o = d.tree
+			}
+			b.WriteString(o.Sprintf("%c", d.quarters[bits]))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+var params = []solver.Param{
+	{Name: "width", Usage: "width of the bathroom in tiles", Default: 101, Example: 11, Min: 1, Max: math.MaxInt32},
+	{Name: "height", Usage: "height of the bathroom in tiles", Default: 103, Example: 7, Min: 1, Max: math.MaxInt32},
+	{Name: "seconds", Usage: "seconds simulated for the safety factor", Default: 100},
+	{Name: "search-limit", Usage: "last second searched for the Christmas tree", Default: 10000},
+}
+
+type solution struct {
+	mode      parse.Mode
+	tracer    *trace.Tracer
+	logger    *slog.Logger
+	swarm     *RobotSwarm
+	params    solver.Params
+	reference bool
+}
+
+func init() {
+	solver.Register(14, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode)      { s.mode = m }
+func (s *solution) SetReference(on bool)      { s.reference = on }
+func (s *solution) SetTracer(t *trace.Tracer) { s.tracer = t }
+func (s *solution) SetLogger(l *slog.Logger)  { s.logger = l }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.swarm, err = parseInput(r, int64(s.params["width"]), int64(s.params["height"]), s.mode)
+	return err
+}
+
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.swarm, int64(s.params["seconds"]))
+	}
+	return partOne(s.swarm, int64(s.params["seconds"]), s.tracer)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.swarm, int64(s.params["search-limit"]))
+	}
+	return partTwo(ctx, s.swarm, int64(s.params["search-limit"]), s.tracer, s.logger)
+}
+
+func partOne(s *RobotSwarm, seconds int64, t *trace.Tracer) int {
+	if t != nil {
+		s.record(t, seconds)
+	}
+	return s.safetyFactor(seconds)
+}
+
+// partTwo searches for the first second on which no two robots share a tile.
+// At trace level it logs a drawing of the room every hundred seconds and on
+// the second it finds.
+func partTwo(ctx context.Context, s *RobotSwarm, limit int64, t *trace.Tracer, logger *slog.Logger) int64 {
+	drawing := logger.Enabled(ctx, logging.LevelTrace)
+	for second := int64(1); second <= limit; second++ {
+		if ctx.Err() != nil {
+			return 0
+		}
+		positions := s.getRobotPositions(second)
+
+		allUnique := true
+		for _, velocities := range positions {
+			if len(velocities) != 1 {
+				allUnique = false
+				break
+			}
+		}
+
+		if allUnique {
+			if t != nil {
+				t.Step(fmt.Sprintf("second %d: christmas tree", second), s.render(second),
+					map[string]any{"second": second, "safety": s.safetyFactor(second)})
+			}
+			logger.Debug("christmas tree found", "second", second)
+			if drawing {
+				logger.Log(ctx, logging.LevelTrace, "room", "second", second, "drawing", s.Display.draw(positions, s.Width, s.Height))
+			}
+			return second
+		}
+
+		if drawing && second%100 == 0 {
+			logger.Log(ctx, logging.LevelTrace, "room", "second", second, "drawing", s.Display.draw(positions, s.Width, s.Height))
+		}
+	}
+	return 0
+}
+
+func parseInput(r io.Reader, width, height int64, mode parse.Mode) (*RobotSwarm, error) {
+	swarm := NewRobotSwarm(width, height)
+	pattern := regexp.MustCompile(`p=(-?\d+),(-?\d+) v=(-?\d+),(-?\d+)`)
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		robot, err := parseRobot(scanner, pattern, width, height)
+		if err != nil {
+			if mode == parse.Lenient {
+				continue
+			}
+			return nil, err
+		}
+		swarm.AddRobot(robot)
+	}
+
+	return swarm, scanner.Err()
+}
+
+func parseRobot(scanner *parse.Scanner, pattern *regexp.Regexp, width, height int64) (Robot, error) {
+	matches := parse.Match(pattern, scanner.Text())
+	if matches == nil || matches[0].Text != scanner.Text() {
+		return Robot{}, scanner.Errorf(0, "expected \"p=X,Y v=DX,DY\"")
+	}
+
+	var nums [4]int64
+	for i := range nums {
+		n, err := scanner.Int64(matches[i+1])
+		if err != nil {
+			return Robot{}, err
+		}
+		nums[i] = n
+	}
+
+	if nums[0] < 0 || nums[0] >= width {
+		return Robot{}, scanner.Errorf(matches[1].Column, "x %d outside a room %d wide", nums[0], width)
+	}
+	if nums[1] < 0 || nums[1] >= height {
+		return Robot{}, scanner.Errorf(matches[2].Column, "y %d outside a room %d tall", nums[1], height)
+	}
+
+	return Robot{
+		Position: Coordinate{x: nums[0], y: nums[1]},
+		Velocity: Coordinate{x: nums[2], y: nums[3]},
+	}, nil
+}
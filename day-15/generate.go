@@ -0,0 +1,43 @@
+package day15
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(15, gen.Generator{Size: "side of the warehouse", Default: 50, Generate: generate, CheckSize: 10})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 3)
+	g := gen.NewGrid(size, size, '#')
+	for y := 1; y < size-1; y++ {
+		for x := 1; x < size-1; x++ {
+			switch n := rng.IntN(20); {
+			case n == 0:
+				g[y][x] = '#'
+			case n < 6:
+				g[y][x] = 'O'
+			default:
+				g[y][x] = '.'
+			}
+		}
+	}
+	g[1+rng.IntN(size-2)][1+rng.IntN(size-2)] = '@'
+
+	var b strings.Builder
+	b.WriteString(g.String())
+	b.WriteByte('\n')
+	moves := size * size * 8
+	for i := range moves {
+		b.WriteByte("^>v<"[rng.IntN(4)])
+		if i%1000 == 999 || i == moves-1 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String(), nil
+}
@@ -1,3 +0,0 @@
-module github.com/reecepm/aoc-2024/day-15
-
-go 1.23.2
@@ -0,0 +1,85 @@
+package day15
+
+import "github.com/reecepm/aoc-2024/grid"
+
+// referenceMove gathers everything the robot would push, box halves pulling
+// in their other half, and moves it all at once unless any of it meets a
+// wall.
+func referenceMove(cells map[grid.Point]Cell, robot grid.Point, d grid.Point) grid.Point {
+	pushed := map[grid.Point]bool{robot: true}
+	queue := []grid.Point{robot}
+	for len(queue) > 0 {
+		next := queue[0].Add(d)
+		queue = queue[1:]
+		switch cells[next] {
+		case Wall:
+			return robot
+		case Box, LBox, RBox:
+			for _, p := range []grid.Point{next, referencePartner(cells, next)} {
+				if !pushed[p] {
+					pushed[p] = true
+					queue = append(queue, p)
+				}
+			}
+		}
+	}
+
+	moved := make(map[grid.Point]Cell, len(pushed))
+	for p := range pushed {
+		moved[p.Add(d)] = cells[p]
+		cells[p] = Empty
+	}
+	for p, c := range moved {
+		cells[p] = c
+	}
+	return robot.Add(d)
+}
+
+// referencePartner returns the other half of a wide box, or the box itself.
+func referencePartner(cells map[grid.Point]Cell, p grid.Point) grid.Point {
+	switch cells[p] {
+	case LBox:
+		return p.Add(grid.Right)
+	case RBox:
+		return p.Add(grid.Left)
+	}
+	return p
+}
+
+func referenceRun(cells map[grid.Point]Cell, robot grid.Point, moves []Direction) int {
+	for _, m := range moves {
+		robot = referenceMove(cells, robot, m.delta())
+	}
+	score := 0
+	for p, c := range cells {
+		if c == Box || c == LBox {
+			score += 100*p.Y + p.X
+		}
+	}
+	return score
+}
+
+func referencePartOne(w *Warehouse) int {
+	cells := make(map[grid.Point]Cell)
+	for p, c := range w.Grid.All() {
+		cells[p] = c
+	}
+	return referenceRun(cells, w.RobotPos, w.Moves)
+}
+
+// referencePartTwo widens the map tile by tile as the puzzle describes.
+func referencePartTwo(w *Warehouse) int {
+	wide := map[Cell][2]Cell{
+		Wall:  {Wall, Wall},
+		Box:   {LBox, RBox},
+		Empty: {Empty, Empty},
+		Robot: {Robot, Empty},
+	}
+	cells := make(map[grid.Point]Cell)
+	for p, c := range w.Grid.All() {
+		cells[grid.Point{X: 2 * p.X, Y: p.Y}] = wide[c][0]
+		cells[grid.Point{X: 2*p.X + 1, Y: p.Y}] = wide[c][1]
+	}
+	robot := grid.Point{X: 2 * w.RobotPos.X, Y: w.RobotPos.Y}
+	return referenceRun(cells, robot, w.Moves)
+}
@@ -0,0 +1,352 @@
+package day15
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/logging"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+type Cell rune
+
+const (
+	Empty Cell = '.'
+	Wall  Cell = '#'
+	Box   Cell = 'O'
+	Robot Cell = '@'
+	LBox  Cell = '['
+	RBox  Cell = ']'
+)
+
+type Direction rune
+
+const (
+	Up    Direction = '^'
+	Down  Direction = 'v'
+	Left  Direction = '<'
+	Right Direction = '>'
+)
+
+func (d Direction) delta() grid.Point {
+	switch d {
+	case Up:
+		return grid.Up
+	case Down:
+		return grid.Down
+	case Left:
+		return grid.Left
+	case Right:
+		return grid.Right
+	}
+	return grid.Point{}
+}
+
+type Warehouse struct {
+	Grid     *grid.Grid[Cell]
+	Moves    []Direction
+	RobotPos grid.Point
+}
+
+func NewWarehouse() *Warehouse {
+	return &Warehouse{}
+}
+
+func (w *Warehouse) Copy() *Warehouse {
+	copy := *w
+	copy.Grid = w.Grid.Clone()
+	return &copy
+}
+
+// String draws the warehouse one row to a line.
+func (w *Warehouse) String() string {
+	var b strings.Builder
+	for y := 0; y < w.Grid.Height; y++ {
+		for _, col := range w.Grid.Row(y) {
+			b.WriteRune(rune(col))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (w *Warehouse) rows() []string {
+	return trace.Rows(w.Grid.Width, w.Grid.Height, func(x, y int) rune {
+		return rune(w.Grid.At(grid.Point{X: x, Y: y}))
+	})
+}
+
+func (w *Warehouse) state(boxType Cell) map[string]any {
+	return map[string]any{"x": w.RobotPos.X, "y": w.RobotPos.Y, "gps": w.CalculateScore(boxType)}
+}
+
+func (w *Warehouse) CalculateScore(boxType Cell) int {
+	total := 0
+	for pos, cell := range w.Grid.All() {
+		if cell == boxType {
+			total += (100 * pos.Y) + pos.X
+		}
+	}
+	return total
+}
+
+type solution struct {
+	mode      parse.Mode
+	tracer    *trace.Tracer
+	logger    *slog.Logger
+	warehouse *Warehouse
+	reference bool
+}
+
+func init() {
+	solver.Register(15, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode)      { s.mode = m }
+func (s *solution) SetReference(on bool)      { s.reference = on }
+func (s *solution) SetTracer(t *trace.Tracer) { s.tracer = t }
+func (s *solution) SetLogger(l *slog.Logger)  { s.logger = l }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.warehouse, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.warehouse)
+	}
+	return partOne(s.warehouse.Copy(), s.tracer, s.logger)
+}
+
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referencePartTwo(s.warehouse)
+	}
+	return partTwo(s.warehouse.Copy(), s.logger)
+}
+
+func (w *Warehouse) canMove(pos grid.Point, dir Direction) bool {
+	next := pos.Add(dir.delta())
+	switch w.Grid.At(next) {
+	case Wall:
+		return false
+	case LBox:
+		if dir == Up || dir == Down {
+			nextRight := next.Add(grid.Right)
+			return w.canMove(next, dir) && w.canMove(nextRight, dir)
+		}
+		return w.canMove(next, dir)
+	case RBox:
+		if dir == Up || dir == Down {
+			nextLeft := next.Add(grid.Left)
+			return w.canMove(nextLeft, dir) && w.canMove(next, dir)
+		}
+		return w.canMove(next, dir)
+	default:
+		return true
+	}
+}
+
+func (w *Warehouse) moveSingleBox(pos grid.Point, dir Direction) bool {
+	var boxPositions []grid.Point
+	curr := pos
+
+	for w.Grid.At(curr) == Box {
+		boxPositions = append(boxPositions, curr)
+		next := curr.Add(dir.delta())
+		if w.Grid.At(next) == Wall {
+			return false
+		}
+		curr = next
+	}
+
+	for i := len(boxPositions) - 1; i >= 0; i-- {
+		pos := boxPositions[i]
+		newBoxPos := pos.Add(dir.delta())
+		w.Grid.Set(newBoxPos, Box)
+		w.Grid.Set(pos, Empty)
+	}
+
+	return true
+}
+
+func (w *Warehouse) moveDoubleBox(pos grid.Point, dir Direction) {
+	next := pos.Add(dir.delta())
+	switch w.Grid.At(next) {
+	case LBox:
+		if dir == Up || dir == Down {
+			w.moveDoubleBox(next.Add(grid.Right), dir)
+		}
+		w.moveDoubleBox(next, dir)
+	case RBox:
+		if dir == Up || dir == Down {
+			w.moveDoubleBox(next.Add(grid.Left), dir)
+		}
+		w.moveDoubleBox(next, dir)
+	}
+	cell, nextCell := w.Grid.At(pos), w.Grid.At(next)
+	w.Grid.Set(next, cell)
+	w.Grid.Set(pos, nextCell)
+}
+
+func (w *Warehouse) processMove(move Direction, isDoubleWidth bool) bool {
+	newPos := w.RobotPos.Add(move.delta())
+
+	if isDoubleWidth {
+		if !w.canMove(w.RobotPos, move) {
+			return false
+		}
+		w.moveDoubleBox(w.RobotPos, move)
+		w.RobotPos = newPos
+		return true
+	}
+
+	if w.Grid.At(newPos) == Wall {
+		return false
+	}
+
+	if w.Grid.At(newPos) == Empty ||
+		w.moveSingleBox(newPos, move) {
+		w.Grid.Set(w.RobotPos, Empty)
+		w.Grid.Set(newPos, Robot)
+		w.RobotPos = newPos
+		return true
+	}
+
+	return false
+}
+
+func partOne(wh *Warehouse, t *trace.Tracer, logger *slog.Logger) int {
+	if t != nil {
+		t.Start(15, "warehouse moves", wh.rows(), wh.state(Box))
+	}
+	for i, move := range wh.Moves {
+		moved := wh.processMove(move, false)
+		if t == nil {
+			continue
+		}
+		note := fmt.Sprintf("move %d: %c", i+1, move)
+		if !moved {
+			note += " (blocked)"
+		}
+		t.Step(note, wh.rows(), wh.state(Box))
+	}
+	logFinal(logger, wh)
+	return wh.CalculateScore(Box)
+}
+
+func partTwo(warehouse *Warehouse, logger *slog.Logger) int {
+	wh := warehouse.Double()
+	for _, move := range wh.Moves {
+		wh.processMove(move, true)
+	}
+	logFinal(logger, wh)
+	return wh.CalculateScore(LBox)
+}
+
+// logFinal logs a drawing of the warehouse after the robot's last move.
+func logFinal(logger *slog.Logger, wh *Warehouse) {
+	if logger.Enabled(context.Background(), logging.LevelTrace) {
+		logger.Log(context.Background(), logging.LevelTrace, "warehouse", "moves", len(wh.Moves), "drawing", wh.String())
+	}
+}
+
+func (w *Warehouse) Double() *Warehouse {
+	newGrid := grid.New[Cell](w.Grid.Width*2, w.Grid.Height)
+
+	robotPos := w.RobotPos
+
+	for pos, cell := range w.Grid.All() {
+		left := grid.Point{X: pos.X * 2, Y: pos.Y}
+		right := left.Add(grid.Right)
+
+		switch cell {
+		case Wall:
+			newGrid.Set(left, Wall)
+			newGrid.Set(right, Wall)
+		case Box:
+			newGrid.Set(left, LBox)
+			newGrid.Set(right, RBox)
+		case Empty:
+			newGrid.Set(left, Empty)
+			newGrid.Set(right, Empty)
+		case Robot:
+			newGrid.Set(left, Robot)
+			newGrid.Set(right, Empty)
+			robotPos = left
+		}
+	}
+
+	return &Warehouse{
+		Grid:     newGrid,
+		Moves:    w.Moves,
+		RobotPos: robotPos,
+	}
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*Warehouse, error) {
+	warehouse := NewWarehouse()
+	scanner := parse.NewScanner(r)
+
+	var lines []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		lines = append(lines, line)
+	}
+
+	robots := 0
+	g, err := grid.FromLines(lines, func(p grid.Point, ch rune) (Cell, error) {
+		cell := Cell(ch)
+		switch cell {
+		case Robot:
+			warehouse.RobotPos = p
+			robots++
+		case Empty, Wall, Box:
+		default:
+			if mode == parse.Strict {
+				return cell, fmt.Errorf("unexpected character %q", ch)
+			}
+		}
+		return cell, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parsing grid: %w", err)
+	}
+	if mode == parse.Strict && robots != 1 {
+		return nil, parse.Errorf(0, 0, "found %d robots, want 1", robots)
+	}
+	warehouse.Grid = g
+
+	var moves []Direction
+	for scanner.Scan() {
+		line := scanner.Text()
+		for i, ch := range line {
+			switch Direction(ch) {
+			case Up, Down, Left, Right:
+				moves = append(moves, Direction(ch))
+			default:
+				if mode == parse.Strict {
+					return nil, scanner.Errorf(i+1, "unexpected move %q", ch)
+				}
+			}
+		}
+	}
+	warehouse.Moves = moves
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning input: %w", err)
+	}
+
+	return warehouse, nil
+}
@@ -0,0 +1,29 @@
+package day16
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(16, gen.Generator{Size: "side of the maze", Default: 141, Generate: generate, CheckSize: 15})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 5) | 1
+	g, path := gen.Maze(rng, size)
+
+	// Knocking through some walls gives the reindeer more than one way round.
+	for y := 1; y < size-1; y++ {
+		for x := 1 + y%2; x < size-1; x += 2 {
+			if rng.IntN(8) == 0 {
+				g[y][x] = '.'
+			}
+		}
+	}
+	g.Set(path[0], 'S')
+	g.Set(path[len(path)-1], 'E')
+	return g.String(), nil
+}
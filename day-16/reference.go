@@ -0,0 +1,86 @@
+package day16
+
+import (
+	"context"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// referenceMoves lists the moves out of a state: turning either way, and
+// stepping forwards unless there is a wall.
+func referenceMoves(m *Maze, s State) ([]State, []int) {
+	to := []State{{s.pos, s.dir.RotateLeft()}, {s.pos, s.dir.RotateRight()}}
+	costs := []int{1000, 1000}
+	if next := s.pos.Add(s.dir); m.isValid(next) {
+		to = append(to, State{next, s.dir})
+		costs = append(costs, 1)
+	}
+	return to, costs
+}
+
+// referenceScores relaxes every move again and again until no score
+// improves, from the start or, when backwards, from every way of facing
+// the end.
+func referenceScores(ctx context.Context, m *Maze, backwards bool) map[State]int {
+	score := make(map[State]int)
+	if backwards {
+		for _, d := range grid.Cardinals {
+			score[State{m.EndPos, d}] = 0
+		}
+	} else {
+		score[State{m.StartPos, m.StartDir}] = 0
+	}
+
+	for changed := true; changed && ctx.Err() == nil; {
+		changed = false
+		for pos, cell := range m.Grid.All() {
+			if cell == Wall {
+				continue
+			}
+			for _, d := range grid.Cardinals {
+				from := State{pos, d}
+				to, costs := referenceMoves(m, from)
+				for i, next := range to {
+					a, b := from, next
+					if backwards {
+						a, b = next, from
+					}
+					s, ok := score[a]
+					if old, seen := score[b]; ok && (!seen || s+costs[i] < old) {
+						score[b] = s + costs[i]
+						changed = true
+					}
+				}
+			}
+		}
+	}
+	return score
+}
+
+func referenceBest(m *Maze, scores map[State]int) int {
+	best := -1
+	for _, d := range grid.Cardinals {
+		if s, ok := scores[State{m.EndPos, d}]; ok && (best < 0 || s < best) {
+			best = s
+		}
+	}
+	return best
+}
+
+func referencePartOne(ctx context.Context, m *Maze) int {
+	return referenceBest(m, referenceScores(ctx, m, false))
+}
+
+// referencePartTwo counts the tiles with a state whose best score from the
+// start and best score on to the end add up to the best score overall.
+func referencePartTwo(ctx context.Context, m *Maze) int {
+	from, to := referenceScores(ctx, m, false), referenceScores(ctx, m, true)
+	best := referenceBest(m, from)
+	tiles := make(map[grid.Point]bool)
+	for s, score := range from {
+		if rest, ok := to[s]; ok && score+rest == best {
+			tiles[s.pos] = true
+		}
+	}
+	return len(tiles)
+}
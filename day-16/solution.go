@@ -0,0 +1,214 @@
+package day16
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/search"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+type Cell rune
+
+const (
+	Empty    Cell = '.'
+	Wall     Cell = '#'
+	Reindeer Cell = 'S'
+	End      Cell = 'E'
+	Explored Cell = 'o'
+)
+
+// Facing maps a direction to the rune drawn for the reindeer in traces.
+var Facing = map[grid.Point]rune{grid.Up: '^', grid.Right: '>', grid.Down: 'v', grid.Left: '<'}
+
+type State struct {
+	pos grid.Point
+	dir grid.Point
+}
+
+type Maze struct {
+	Grid     *grid.Grid[Cell]
+	StartPos grid.Point
+	EndPos   grid.Point
+	StartDir grid.Point
+}
+
+func NewMaze() *Maze {
+	return &Maze{}
+}
+
+func (m *Maze) FindOptimalPath(ctx context.Context) int {
+	cost, ok := m.search(ctx).Cost()
+	if !ok {
+		return -1
+	}
+	return cost
+}
+
+func (m *Maze) collectOptimalPaths(ctx context.Context) map[grid.Point]bool {
+	res := m.search(ctx)
+	optimalTiles := make(map[grid.Point]bool)
+	for state := range res.OnShortestPaths(res.Goals...) {
+		optimalTiles[state.pos] = true
+	}
+	return optimalTiles
+}
+
+func (m *Maze) search(ctx context.Context) *search.Result[State] {
+	return m.searchTraced(ctx, nil)
+}
+
+// searchTraced runs the search, marking each tile in the trace as the search
+// first expands it.
+func (m *Maze) searchTraced(ctx context.Context, t *trace.Tracer) *search.Result[State] {
+	neighbours := m.neighbours
+	if t != nil {
+		explored := make(map[grid.Point]bool)
+		neighbours = func(s State) []search.Edge[State] {
+			if !explored[s.pos] && s.pos != m.StartPos && s.pos != m.EndPos {
+				explored[s.pos] = true
+				t.Change(fmt.Sprintf("explored %d,%d", s.pos.X, s.pos.Y),
+					[]trace.Cell{{X: s.pos.X, Y: s.pos.Y, Ch: string(Explored)}},
+					map[string]any{"explored": len(explored)})
+			}
+			return m.neighbours(s)
+		}
+	}
+
+	start := State{pos: m.StartPos, dir: m.StartDir}
+	return search.AStar(ctx, []State{start}, neighbours, m.heuristic, m.isEnd)
+}
+
+// record traces the search and then the reindeer following the cheapest path
+// it found.
+func (m *Maze) record(ctx context.Context, t *trace.Tracer) int {
+	t.Start(16, "reindeer maze", trace.Rows(m.Grid.Width, m.Grid.Height, func(x, y int) rune {
+		return rune(m.Grid.At(grid.Point{X: x, Y: y}))
+	}), map[string]any{"explored": 0})
+
+	res := m.searchTraced(ctx, t)
+	cost, ok := res.Cost()
+	if !ok {
+		return -1
+	}
+
+	path := res.Path(res.Goals[0])
+	for i, state := range path[1:] {
+		note := "turned"
+		if state.pos != path[i].pos {
+			note = fmt.Sprintf("moved to %d,%d", state.pos.X, state.pos.Y)
+		}
+		t.Change(note, []trace.Cell{{X: state.pos.X, Y: state.pos.Y, Ch: string(Facing[state.dir])}},
+			map[string]any{"score": res.Dist[state]})
+	}
+	return cost
+}
+
+func (m *Maze) neighbours(current State) []search.Edge[State] {
+	edges := []search.Edge[State]{
+		{To: State{current.pos, current.dir.RotateRight()}, Cost: 1000},
+		{To: State{current.pos, current.dir.RotateLeft()}, Cost: 1000},
+	}
+
+	if next := current.pos.Add(current.dir); m.isValid(next) {
+		edges = append(edges, search.Edge[State]{To: State{next, current.dir}, Cost: 1})
+	}
+	return edges
+}
+
+func (m *Maze) heuristic(s State) int {
+	return s.pos.Manhattan(m.EndPos)
+}
+
+func (m *Maze) isEnd(s State) bool {
+	return s.pos == m.EndPos
+}
+
+func (m *Maze) isValid(pos grid.Point) bool {
+	cell, ok := m.Grid.Get(pos)
+	return ok && cell != Wall
+}
+
+type solution struct {
+	mode      parse.Mode
+	tracer    *trace.Tracer
+	maze      *Maze
+	reference bool
+}
+
+func init() {
+	solver.Register(16, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode)      { s.mode = m }
+func (s *solution) SetReference(on bool)      { s.reference = on }
+func (s *solution) SetTracer(t *trace.Tracer) { s.tracer = t }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.maze, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(ctx, s.maze)
+	}
+	return partOne(ctx, s.maze, s.tracer)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.maze)
+	}
+	return partTwo(ctx, s.maze)
+}
+
+func partOne(ctx context.Context, m *Maze, t *trace.Tracer) int {
+	if t != nil {
+		return m.record(ctx, t)
+	}
+	return m.FindOptimalPath(ctx)
+}
+
+func partTwo(ctx context.Context, m *Maze) int {
+	return len(m.collectOptimalPaths(ctx))
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*Maze, error) {
+	maze := NewMaze()
+
+	var starts, ends int
+	g, err := grid.Parse(r, func(p grid.Point, ch rune) (Cell, error) {
+		cell := Cell(ch)
+		switch cell {
+		case Reindeer:
+			maze.StartPos = p
+			starts++
+		case End:
+			maze.EndPos = p
+			ends++
+		case Empty, Wall:
+		default:
+			if mode == parse.Strict {
+				return cell, fmt.Errorf("unexpected character %q", ch)
+			}
+		}
+		return cell, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scanning input: %w", err)
+	}
+	if mode == parse.Strict && (starts != 1 || ends != 1) {
+		return nil, parse.Errorf(0, 0, "found %d starts and %d ends, want one of each", starts, ends)
+	}
+
+	maze.Grid = g
+	maze.StartDir = grid.Right
+
+	return maze, nil
+}
@@ -0,0 +1,24 @@
+package day17
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(17, gen.Generator{Size: "octal digits of register A", Default: 10, Generate: generate, CheckSize: 16})
+}
+
+// generate writes a program of the same shape as the real ones, which shift
+// A right by three bits per output until it is zero. Size is capped at 16
+// digits, the length of the program.
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = min(max(size, 1), 16)
+	a := 1 << (3 * (size - 1))
+	a += rng.IntN(7 * a)
+	program := fmt.Sprintf("2,4,1,%d,7,5,1,%d,4,%d,5,5,0,3,3,0", 1+rng.IntN(7), 1+rng.IntN(7), rng.IntN(8))
+	return fmt.Sprintf("Register A: %d\nRegister B: 0\nRegister C: 0\n\nProgram: %s\n", a, program), nil
+}
@@ -0,0 +1,84 @@
+package day17
+
+import "slices"
+
+// referenceRun interprets the program as the list of numbers it was written
+// as, following the puzzle's description of each instruction.
+func referenceRun(program []int, a, b, c int) []int {
+	combo := func(operand int) int {
+		switch operand {
+		case 4:
+			return a
+		case 5:
+			return b
+		case 6:
+			return c
+		}
+		return operand
+	}
+
+	var out []int
+	for ip := 0; ip+1 < len(program); ip += 2 {
+		operand := program[ip+1]
+		switch program[ip] {
+		case 0:
+			a /= 1 << combo(operand)
+		case 1:
+			b ^= operand
+		case 2:
+			b = combo(operand) % 8
+		case 3:
+			if a != 0 {
+				ip = operand - 2
+			}
+		case 4:
+			b ^= c
+		case 5:
+			out = append(out, combo(operand)%8)
+		case 6:
+			b = a / (1 << combo(operand))
+		case 7:
+			c = a / (1 << combo(operand))
+		}
+	}
+	return out
+}
+
+func referencePartOne(p *ProgramInput) string {
+	program := convertProgramToOutput(p.Instructions)
+	return formatOutput(referenceRun(program, p.Comp.A, p.Comp.B, p.Comp.C))
+}
+
+// referencePartTwo relies on the shape every puzzle program shares: it
+// prints once and shifts A right by three bits each time round. So A can be
+// built an octal digit at a time from the last output, keeping every
+// candidate whose output matches so far, and the smallest that reproduces
+// the whole program wins.
+func referencePartTwo(p *ProgramInput) int {
+	program := convertProgramToOutput(p.Instructions)
+	if len(program) < 2 || !slices.Equal(program[len(program)-2:], []int{3, 0}) {
+		return -1
+	}
+
+	candidates := []int{0}
+	for i := len(program) - 1; i >= 0; i-- {
+		var next []int
+		for _, c := range candidates {
+			for digit := range 8 {
+				a := c*8 + digit
+				if slices.Equal(referenceRun(program, a, p.Comp.B, p.Comp.C), program[i:]) {
+					next = append(next, a)
+				}
+			}
+		}
+		candidates = next
+	}
+
+	best := -1
+	for _, a := range candidates {
+		if a > 0 && (best < 0 || a < best) {
+			best = a
+		}
+	}
+	return best
+}
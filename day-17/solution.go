@@ -1,13 +1,16 @@
-package main
+package day17
 
 import (
-	"bufio"
+	"context"
 	"fmt"
-	"log"
-	"os"
+	"io"
 	"reflect"
 	"strconv"
 	"strings"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
 )
 
 type Instruction struct {
@@ -21,12 +24,23 @@ type Computer struct {
 	Prog    []Instruction
 }
 
+// Mnemonics names the opcodes in traces.
+var Mnemonics = []string{"adv", "bxl", "bst", "jnz", "bxc", "out", "bdv", "cdv"}
+
 func (c *Computer) Run() []int {
+	return c.run(nil)
+}
+
+func (c *Computer) run(t *trace.Tracer) []int {
 	var out []int
 	c.IP = 0
+	if t != nil {
+		t.Start(17, "3-bit computer", nil, c.state(out))
+	}
 	for c.IP < len(c.Prog) {
 		in := c.Prog[c.IP]
 		v := c.OperandValue(in.Operand)
+		next := c.IP + 1
 		switch in.Opcode {
 		case 0:
 			c.A >>= v
@@ -36,8 +50,7 @@ func (c *Computer) Run() []int {
 			c.B = v % 8
 		case 3:
 			if c.A != 0 {
-				c.IP = in.Operand
-				continue
+				next = in.Operand
 			}
 		case 4:
 			c.B = c.B ^ c.C
@@ -48,11 +61,25 @@ func (c *Computer) Run() []int {
 		case 7:
 			c.C = c.A >> v
 		}
-		c.IP++
+		if t != nil {
+			t.Step(fmt.Sprintf("%d: %s %d", c.IP, mnemonic(in.Opcode), in.Operand), nil, c.state(out))
+		}
+		c.IP = next
 	}
 	return out
 }
 
+func mnemonic(opcode int) string {
+	if opcode < 0 || opcode >= len(Mnemonics) {
+		return fmt.Sprintf("op%d", opcode)
+	}
+	return Mnemonics[opcode]
+}
+
+func (c *Computer) state(out []int) map[string]any {
+	return map[string]any{"a": c.A, "b": c.B, "c": c.C, "out": formatOutput(out)}
+}
+
 func (c *Computer) OperandValue(op int) int {
 	switch op {
 	case 0, 1, 2, 3:
@@ -81,23 +108,49 @@ func convertProgramToOutput(prog []Instruction) []int {
 	return res
 }
 
-func main() {
-	pr, err := parseInput("input.txt")
-	if err != nil {
-		panic(err)
+type solution struct {
+	mode      parse.Mode
+	tracer    *trace.Tracer
+	program   *ProgramInput
+	reference bool
+}
+
+func init() {
+	solver.Register(17, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode)      { s.mode = m }
+func (s *solution) SetReference(on bool)      { s.reference = on }
+func (s *solution) SetTracer(t *trace.Tracer) { s.tracer = t }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.program, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referencePartOne(s.program)
 	}
-	p1 := partOne(pr)
-	p2 := partTwo(pr)
+	return partOne(s.program, s.tracer)
+}
 
-	log.Printf("part1: %s", p1)
-	log.Printf("part2: %d", p2)
+func (s *solution) PartTwo(context.Context) any {
+	if s.reference {
+		return referencePartTwo(s.program)
+	}
+	return partTwo(s.program)
 }
 
-func partOne(p *ProgramInput) string {
+func partOne(p *ProgramInput, t *trace.Tracer) string {
 	c := Computer{p.Comp.A, p.Comp.B, p.Comp.C, p.Comp.IP, p.Instructions}
-	o := c.Run()
-	s := make([]string, len(o))
-	for i, n := range o {
+	return formatOutput(c.run(t))
+}
+
+func formatOutput(out []int) string {
+	s := make([]string, len(out))
+	for i, n := range out {
 		s[i] = strconv.Itoa(n)
 	}
 	return strings.Join(s, ",")
@@ -158,51 +211,65 @@ func confirmReconstruction(prog []Instruction, a int) bool {
 	return reflect.DeepEqual(res, expect)
 }
 
-func parseInput(path string) (*ProgramInput, error) {
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("opening: %w", err)
-	}
-	defer f.Close()
-	s := bufio.NewScanner(f)
+func parseInput(r io.Reader, mode parse.Mode) (*ProgramInput, error) {
+	s := parse.NewScanner(r)
 	p := &ProgramInput{}
+	registers := map[string]*int{
+		"Register A": &p.Comp.A,
+		"Register B": &p.Comp.B,
+		"Register C": &p.Comp.C,
+	}
 	for i := 0; i < 3 && s.Scan(); i++ {
 		line := s.Text()
 		if line == "" {
 			continue
 		}
-		parts := strings.Split(line, ": ")
+		parts := parse.Split(line, ": ")
 		if len(parts) != 2 {
-			continue
+			if mode == parse.Lenient {
+				continue
+			}
+			return nil, s.Errorf(0, "expected \"Register X: value\"")
 		}
-		n, err := strconv.Atoi(parts[1])
+		n, err := s.Int(parts[1])
 		if err != nil {
-			return nil, fmt.Errorf("reg parse: %w", err)
+			return nil, err
 		}
-		switch parts[0] {
-		case "Register A":
-			p.Comp.A = n
-		case "Register B":
-			p.Comp.B = n
-		case "Register C":
-			p.Comp.C = n
+		reg, ok := registers[parts[0].Text]
+		if !ok {
+			if mode == parse.Lenient {
+				continue
+			}
+			return nil, s.Errorf(1, "unknown register %q", parts[0].Text)
 		}
+		*reg = n
+	}
+	if s.Scan() && s.Text() != "" && mode == parse.Strict {
+		return nil, s.Errorf(0, "expected a blank line after the registers")
 	}
-	s.Scan()
 	for s.Scan() {
 		line := s.Text()
 		if !strings.HasPrefix(line, "Program: ") {
-			continue
+			if mode == parse.Lenient {
+				continue
+			}
+			return nil, s.Errorf(0, "expected \"Program: \"")
+		}
+		in := parse.Split(strings.TrimPrefix(line, "Program: "), ",")
+		if len(in)%2 != 0 && mode == parse.Strict {
+			return nil, s.Errorf(0, "program has an odd number of values")
+		}
+		for i := range in {
+			in[i].Column += len("Program: ")
 		}
-		in := strings.Split(strings.TrimPrefix(line, "Program: "), ",")
 		for i := 0; i < len(in)-1; i += 2 {
-			op, err := strconv.Atoi(in[i])
+			op, err := s.Int(in[i])
 			if err != nil {
-				return nil, fmt.Errorf("op parse: %w", err)
+				return nil, err
 			}
-			arg, err := strconv.Atoi(in[i+1])
+			arg, err := s.Int(in[i+1])
 			if err != nil {
-				return nil, fmt.Errorf("arg parse: %w", err)
+				return nil, err
 			}
 			p.Instructions = append(p.Instructions, Instruction{op, arg})
 		}
@@ -0,0 +1,33 @@
+package day18
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(18, gen.Generator{Size: "side of the memory space", Default: 71, Generate: generate, CheckSize: 12})
+}
+
+// generate drops bytes on most of the memory space except the two corners,
+// so the exit is cut off eventually. Part one counts the first fifth.
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 3)
+	cells := rng.Perm(size * size)
+	var b strings.Builder
+	count := 0
+	for _, c := range cells {
+		if c == 0 || c == size*size-1 {
+			continue
+		}
+		fmt.Fprintf(&b, "%d,%d\n", c%size, c/size)
+		if count++; count >= size*size*7/10 {
+			break
+		}
+	}
+	return b.String(), solver.Params{"size": size, "bytes": min(count, size*size/5)}
+}
@@ -0,0 +1,60 @@
+package day18
+
+import (
+	"context"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// referenceSteps walks the memory space breadth first with the first fallen
+// bytes in place and returns the steps to the exit, or -1.
+func referenceSteps(m *MemorySpace, corruptions []grid.Point, fallen int) int {
+	size := m.bounds.X + 1
+	blocked := make([][]bool, size)
+	for y := range blocked {
+		blocked[y] = make([]bool, size)
+	}
+	for _, c := range corruptions[:min(fallen, len(corruptions))] {
+		blocked[c.Y][c.X] = true
+	}
+	if blocked[0][0] {
+		return -1
+	}
+
+	steps := map[grid.Point]int{{}: 0}
+	queue := []grid.Point{{}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur == m.bounds {
+			return steps[cur]
+		}
+		for _, next := range cur.Neighbours4() {
+			if next.X < 0 || next.Y < 0 || next.X >= size || next.Y >= size || blocked[next.Y][next.X] {
+				continue
+			}
+			if _, seen := steps[next]; !seen {
+				steps[next] = steps[cur] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return -1
+}
+
+func referencePartOne(m *MemorySpace, corruptions []grid.Point, fallen int) int {
+	return referenceSteps(m, corruptions, fallen)
+}
+
+// referencePartTwo drops the bytes one at a time until the exit is cut off.
+func referencePartTwo(ctx context.Context, m *MemorySpace, corruptions []grid.Point) grid.Point {
+	for i, c := range corruptions {
+		if ctx.Err() != nil {
+			break
+		}
+		if referenceSteps(m, corruptions, i+1) < 0 {
+			return c
+		}
+	}
+	return grid.Point{X: -1, Y: -1}
+}
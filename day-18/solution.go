@@ -0,0 +1,171 @@
+package day18
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/search"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type MemorySpace struct {
+	corrupted *grid.Sparse[struct{}]
+	bounds    grid.Point
+}
+
+func NewMemorySpace(size int) *MemorySpace {
+	return &MemorySpace{
+		corrupted: grid.NewSparse[struct{}](),
+		bounds:    grid.Point{X: size - 1, Y: size - 1},
+	}
+}
+
+func (m *MemorySpace) addCorruption(coord grid.Point) {
+	m.corrupted.Set(coord, struct{}{})
+}
+
+func (m *MemorySpace) isValid(pos grid.Point) bool {
+	return pos.X >= 0 && pos.X <= m.bounds.X &&
+		pos.Y >= 0 && pos.Y <= m.bounds.Y &&
+		!m.isCorrupted(pos)
+}
+
+func (m *MemorySpace) isCorrupted(pos grid.Point) bool {
+	return m.corrupted.Has(pos)
+}
+
+func (m *MemorySpace) findPath(ctx context.Context, start, end grid.Point) int {
+	res := search.BFS(ctx, []grid.Point{start}, m.neighbours, func(pos grid.Point) bool {
+		return pos == end
+	})
+
+	steps, ok := res.Cost()
+	if !ok {
+		return -1
+	}
+	return steps
+}
+
+func (m *MemorySpace) neighbours(pos grid.Point) []grid.Point {
+	var out []grid.Point
+	for _, next := range pos.Neighbours4() {
+		if m.isValid(next) {
+			out = append(out, next)
+		}
+	}
+	return out
+}
+
+func (m *MemorySpace) copyWithCorruption(corruptions []grid.Point, limit int) *MemorySpace {
+	copy := NewMemorySpace(m.bounds.X + 1)
+
+	for i := 0; i < limit && i < len(corruptions); i++ {
+		copy.addCorruption(corruptions[i])
+	}
+
+	return copy
+}
+
+var params = []solver.Param{
+	{Name: "size", Usage: "width and height of the memory space", Default: 71, Example: 7, Min: 1},
+	{Name: "bytes", Usage: "bytes that have fallen before part one", Default: 1024, Example: 12},
+}
+
+type solution struct {
+	mode        parse.Mode
+	memory      *MemorySpace
+	corruptions []grid.Point
+	params      solver.Params
+	reference   bool
+}
+
+func init() {
+	solver.Register(18, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.memory, s.corruptions, err = parseInput(r, s.params["size"], s.mode)
+	return err
+}
+
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(s.memory, s.corruptions, s.params["bytes"])
+	}
+	return partOne(ctx, s.memory, s.corruptions, s.params["bytes"])
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.memory, s.corruptions)
+	}
+	return partTwo(ctx, s.memory, s.corruptions)
+}
+
+func partOne(ctx context.Context, memory *MemorySpace, corruptions []grid.Point, fallen int) int {
+	mem := memory.copyWithCorruption(corruptions, fallen)
+	return mem.findPath(ctx, grid.Point{}, mem.bounds)
+}
+
+func partTwo(ctx context.Context, memory *MemorySpace, corruptions []grid.Point) grid.Point {
+	index := sort.Search(len(corruptions), func(i int) bool {
+		mem := memory.copyWithCorruption(corruptions, i+1)
+		return mem.findPath(ctx, grid.Point{}, mem.bounds) == -1
+	})
+	if index == len(corruptions) {
+		return grid.Point{X: -1, Y: -1}
+	}
+	return corruptions[index]
+}
+
+func parseInput(r io.Reader, size int, mode parse.Mode) (*MemorySpace, []grid.Point, error) {
+	memory := NewMemorySpace(size)
+	var corruptions []grid.Point
+
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		coord, err := parseCoord(scanner, size)
+		if err != nil {
+			if mode == parse.Lenient {
+				continue
+			}
+			return nil, nil, err
+		}
+
+		corruptions = append(corruptions, coord)
+		memory.addCorruption(coord)
+	}
+
+	return memory, corruptions, scanner.Err()
+}
+
+func parseCoord(scanner *parse.Scanner, size int) (grid.Point, error) {
+	parts := parse.Split(scanner.Text(), ",")
+	if len(parts) != 2 {
+		return grid.Point{}, scanner.Errorf(0, "expected \"X,Y\"")
+	}
+
+	var coord grid.Point
+	var err error
+	if coord.X, err = scanner.Int(parts[0]); err != nil {
+		return grid.Point{}, err
+	}
+	if coord.Y, err = scanner.Int(parts[1]); err != nil {
+		return grid.Point{}, err
+	}
+
+	if coord.X < 0 || coord.X >= size || coord.Y < 0 || coord.Y >= size {
+		return grid.Point{}, scanner.Errorf(0, "%d,%d outside a %dx%d memory space", coord.X, coord.Y, size, size)
+	}
+	return coord, nil
+}
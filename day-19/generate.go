@@ -0,0 +1,55 @@
+package day19
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(19, gen.Generator{Size: "designs", Default: 400, Generate: generate, CheckSize: 20})
+}
+
+const stripes = "wubrg"
+
+func randomStripes(rng *rand.Rand, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = stripes[rng.IntN(len(stripes))]
+	}
+	return string(b)
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 1)
+	// Leaving out one single stripe towel makes some designs impossible.
+	seen := map[string]bool{string(stripes[rng.IntN(len(stripes))]): true}
+	var towels []string
+	for len(towels) < min(447, 8+size) {
+		if t := randomStripes(rng, 1+rng.IntN(8)); !seen[t] {
+			seen[t] = true
+			towels = append(towels, t)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(towels, ", "))
+	b.WriteString("\n\n")
+	for range size {
+		// Half the designs are laid out from towels and so are possible.
+		length := 20 + rng.IntN(41)
+		if rng.IntN(2) == 0 {
+			b.WriteString(randomStripes(rng, length))
+		} else {
+			var design strings.Builder
+			for design.Len() < length {
+				design.WriteString(towels[rng.IntN(len(towels))])
+			}
+			b.WriteString(design.String())
+		}
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,45 @@
+package day19
+
+import (
+	"context"
+	"strings"
+)
+
+// referenceWays counts the arrangements of each suffix of the design, from
+// the shortest up, by trying every towel at its start.
+func referenceWays(towels []string, design string) int {
+	ways := make([]int, len(design)+1)
+	ways[len(design)] = 1
+	for i := len(design) - 1; i >= 0; i-- {
+		for _, t := range towels {
+			if strings.HasPrefix(design[i:], t) {
+				ways[i] += ways[i+len(t)]
+			}
+		}
+	}
+	return ways[0]
+}
+
+func referencePartOne(ctx context.Context, o *Onsen) int {
+	count := 0
+	for _, d := range o.designs {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if referenceWays(o.towels, d) > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func referencePartTwo(ctx context.Context, o *Onsen) int {
+	total := 0
+	for _, d := range o.designs {
+		if ctx.Err() != nil {
+			return 0
+		}
+		total += referenceWays(o.towels, d)
+	}
+	return total
+}
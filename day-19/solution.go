@@ -0,0 +1,168 @@
+package day19
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/memo"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+// Stripes lists the colours a towel stripe can have.
+const Stripes = "wubrg"
+
+type Onsen struct {
+	towels  []string
+	designs []string
+}
+
+func NewOnsen() *Onsen {
+	return &Onsen{
+		towels:  make([]string, 0),
+		designs: make([]string, 0),
+	}
+}
+
+// solve counts the ways to make design from the towels. The count for a
+// suffix only depends on the towels, so one cache can serve every design.
+func (o *Onsen) solve(design string, cache *memo.Cache[string, int]) (bool, int) {
+	if design == "" {
+		return true, 1
+	}
+
+	if count, exists := cache.Get(design); exists {
+		return count > 0, count
+	}
+
+	count := 0
+	for _, towel := range o.towels {
+		if !strings.HasPrefix(design, towel) {
+			continue
+		}
+
+		if possible, subCount := o.solve(design[len(towel):], cache); possible {
+			count += subCount
+		}
+	}
+
+	cache.Put(design, count)
+	return count > 0, count
+}
+
+type solution struct {
+	mode      parse.Mode
+	onsen     *Onsen
+	reference bool
+}
+
+func init() {
+	solver.Register(19, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.onsen, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referencePartOne(ctx, s.onsen)
+	}
+	return partOne(ctx, s.onsen)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referencePartTwo(ctx, s.onsen)
+	}
+	return partTwo(ctx, s.onsen)
+}
+
+func newDesignCache() *memo.Cache[string, int] {
+	return memo.New[string, int](memo.Options{Name: "day19/designs"})
+}
+
+func partOne(ctx context.Context, o *Onsen) int {
+	count := 0
+	cache := newDesignCache()
+
+	for _, design := range o.designs {
+		if ctx.Err() != nil {
+			return 0
+		}
+		possible, _ := o.solve(design, cache)
+		if possible {
+			count++
+		}
+	}
+	return count
+}
+
+func partTwo(ctx context.Context, o *Onsen) int {
+	total := 0
+	cache := newDesignCache()
+
+	for _, design := range o.designs {
+		if ctx.Err() != nil {
+			return 0
+		}
+		_, ways := o.solve(design, cache)
+		total += ways
+	}
+	return total
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*Onsen, error) {
+	onsen := NewOnsen()
+	scanner := parse.NewScanner(r)
+
+	if scanner.Scan() {
+		for _, towel := range parse.Split(scanner.Text(), ", ") {
+			if err := checkStripes(scanner, towel); err != nil && mode == parse.Strict {
+				return nil, err
+			}
+			onsen.towels = append(onsen.towels, towel.Text)
+		}
+	}
+
+	if scanner.Scan() && scanner.Text() != "" && mode == parse.Strict {
+		return nil, scanner.Errorf(0, "expected a blank line after the towels")
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		if err := checkStripes(scanner, parse.Field{Text: line, Column: 1}); err != nil && mode == parse.Strict {
+			return nil, err
+		}
+		onsen.designs = append(onsen.designs, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(onsen.towels) == 0 && mode == parse.Strict {
+		return nil, &parse.Error{Err: parse.ErrEmpty}
+	}
+
+	return onsen, nil
+}
+
+// checkStripes reports a pattern that is empty or uses an unknown colour.
+func checkStripes(scanner *parse.Scanner, f parse.Field) error {
+	if f.Text == "" {
+		return scanner.Errorf(f.Column, "empty pattern")
+	}
+	if i := strings.IndexFunc(f.Text, func(r rune) bool { return !strings.ContainsRune(Stripes, r) }); i >= 0 {
+		return scanner.Errorf(f.Column+i, "unknown stripe colour %q", f.Text[i])
+	}
+	return nil
+}
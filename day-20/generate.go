@@ -0,0 +1,26 @@
+package day20
+
+import (
+	"math/rand/v2"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(20, gen.Generator{Size: "side of the racetrack", Default: 141, Generate: generate, CheckSize: 21})
+}
+
+// generate lays a single track along the route through a maze, and scales
+// the saving a cheat must make with its length as the real input does.
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = max(size, 5) | 1
+	maze, path := gen.Maze(rng, size)
+	g := gen.NewGrid(size, size, '#')
+	for _, p := range path {
+		g.Set(p, maze.At(p))
+	}
+	g.Set(path[0], 'S')
+	g.Set(path[len(path)-1], 'E')
+	return g.String(), solver.Params{"min-saving": max(2, len(path)/100)}
+}
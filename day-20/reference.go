@@ -0,0 +1,48 @@
+package day20
+
+import (
+	"context"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// referenceDistances returns the distance along the track from `from` to
+// every reachable track position.
+func referenceDistances(m *Maze, from grid.Point) map[grid.Point]int {
+	dist := map[grid.Point]int{from: 0}
+	queue := []grid.Point{from}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range cur.Neighbours4() {
+			if _, seen := dist[next]; !seen && m.isValid(next) {
+				dist[next] = dist[cur] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return dist
+}
+
+// referenceCheats tries a cheat between every pair of track positions close
+// enough together, timing the race through it from start to end.
+func referenceCheats(ctx context.Context, m *Maze, maxCheat, minSaving int) int {
+	fromStart, toEnd := referenceDistances(m, m.start), referenceDistances(m, m.end)
+	normal, ok := fromStart[m.end]
+	if !ok {
+		return 0
+	}
+
+	count := 0
+	for p, before := range fromStart {
+		if ctx.Err() != nil {
+			return 0
+		}
+		for q, after := range toEnd {
+			if d := p.Manhattan(q); d <= maxCheat && normal-(before+d+after) >= minSaving {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,147 @@
+package day20
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/reecepm/aoc-2024/grid"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/search"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Maze struct {
+	grid  *grid.Grid[rune]
+	start grid.Point
+	end   grid.Point
+}
+
+func NewMaze() *Maze {
+	return &Maze{}
+}
+
+func (m *Maze) FindCheats(ctx context.Context, maxCheatDist, minSaving int) int {
+	path := m.findPath(ctx)
+	count := 0
+
+	for i := 0; i < len(path)-2; i++ {
+		if ctx.Err() != nil {
+			return 0
+		}
+		for j := i + 2; j < len(path); j++ {
+			cheatDist := path[i].Manhattan(path[j])
+			if cheatDist <= maxCheatDist {
+				saving := (j - i) - cheatDist
+				if saving >= minSaving {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+func (m *Maze) findPath(ctx context.Context) []grid.Point {
+	res := search.BFS(ctx, []grid.Point{m.start}, m.neighbours, func(pos grid.Point) bool {
+		return pos == m.end
+	})
+	return res.Path(m.end)
+}
+
+func (m *Maze) neighbours(pos grid.Point) []grid.Point {
+	var out []grid.Point
+	for _, next := range pos.Neighbours4() {
+		if m.isValid(next) {
+			out = append(out, next)
+		}
+	}
+	return out
+}
+
+func (m *Maze) isValid(pos grid.Point) bool {
+	cell, ok := m.grid.Get(pos)
+	return ok && cell != '#'
+}
+
+var params = []solver.Param{
+	{Name: "min-saving", Usage: "picoseconds a cheat must save to be counted", Default: 100, Example: 50},
+	{Name: "part1-cheat", Usage: "longest cheat allowed in part one, in picoseconds", Default: 2},
+	{Name: "part2-cheat", Usage: "longest cheat allowed in part two, in picoseconds", Default: 20},
+}
+
+type solution struct {
+	mode      parse.Mode
+	maze      *Maze
+	params    solver.Params
+	reference bool
+}
+
+func init() {
+	solver.Register(20, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.maze, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referenceCheats(ctx, s.maze, s.params["part1-cheat"], s.params["min-saving"])
+	}
+	return partOne(ctx, s.maze, s.params["part1-cheat"], s.params["min-saving"])
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referenceCheats(ctx, s.maze, s.params["part2-cheat"], s.params["min-saving"])
+	}
+	return partTwo(ctx, s.maze, s.params["part2-cheat"], s.params["min-saving"])
+}
+
+func partOne(ctx context.Context, m *Maze, maxCheat, minSaving int) int {
+	return m.FindCheats(ctx, maxCheat, minSaving)
+}
+
+func partTwo(ctx context.Context, m *Maze, maxCheat, minSaving int) int {
+	return m.FindCheats(ctx, maxCheat, minSaving)
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*Maze, error) {
+	maze := NewMaze()
+
+	var starts, ends int
+	g, err := grid.Parse(r, func(p grid.Point, ch rune) (rune, error) {
+		switch ch {
+		case 'S':
+			maze.start = p
+			starts++
+		case 'E':
+			maze.end = p
+			ends++
+		case '.', '#':
+		default:
+			if mode == parse.Strict {
+				return ch, fmt.Errorf("unexpected character %q", ch)
+			}
+		}
+		return ch, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mode == parse.Strict && (starts != 1 || ends != 1) {
+		return nil, parse.Errorf(0, 0, "found %d starts and %d ends, want one of each", starts, ends)
+	}
+
+	maze.grid = g
+	return maze, nil
+}
@@ -0,0 +1,22 @@
+package day21
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(21, gen.Generator{Size: "door codes", Default: 5, Generate: generate, CheckSize: 5, CheckParams: solver.Params{"part2-robots": 3}})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var b strings.Builder
+	for range max(size, 1) {
+		fmt.Fprintf(&b, "%03dA\n", rng.IntN(1000))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,109 @@
+package day21
+
+import (
+	"context"
+	"strings"
+)
+
+var (
+	referenceNumeric     = []string{"789", "456", "123", " 0A"}
+	referenceDirectional = []string{" ^A", "<v>"}
+)
+
+// referenceArm is where one robot arm points on its keypad.
+type referenceArm struct{ x, y int }
+
+// referenceState is every arm in the chain, the numeric keypad's last, and
+// how much of the code has been typed.
+type referenceState struct {
+	arms  string
+	typed int
+}
+
+func referenceFind(pad []string, button byte) referenceArm {
+	for y, row := range pad {
+		if x := strings.IndexByte(row, button); x >= 0 {
+			return referenceArm{x, y}
+		}
+	}
+	panic("day21: no button " + string(button))
+}
+
+// referencePress returns the state after the human presses button, or false
+// when the press moves an arm over a gap or types the wrong digit.
+func referencePress(st referenceState, code string, robots int, button byte) (referenceState, bool) {
+	arms := []byte(st.arms)
+	for i := 0; i <= robots; i++ {
+		pad := referenceDirectional
+		if i == robots {
+			pad = referenceNumeric
+		}
+		x, y := int(arms[2*i]), int(arms[2*i+1])
+		switch button {
+		case '^':
+			y--
+		case 'v':
+			y++
+		case '<':
+			x--
+		case '>':
+			x++
+		case 'A':
+			pressed := pad[y][x]
+			if i < robots {
+				button = pressed
+				continue
+			}
+			if pressed != code[st.typed] {
+				return st, false
+			}
+			return referenceState{st.arms, st.typed + 1}, true
+		}
+		if y < 0 || y >= len(pad) || x < 0 || x >= len(pad[y]) || pad[y][x] == ' ' {
+			return st, false
+		}
+		arms[2*i], arms[2*i+1] = byte(x), byte(y)
+		return referenceState{string(arms), st.typed}, true
+	}
+	return st, false
+}
+
+// referencePresses searches breadth first over the position of every arm for
+// the fewest presses the human needs to type code.
+func referencePresses(ctx context.Context, code string, robots int) int {
+	var arms []byte
+	for i := 0; i <= robots; i++ {
+		a := referenceFind(referenceDirectional, 'A')
+		if i == robots {
+			a = referenceFind(referenceNumeric, 'A')
+		}
+		arms = append(arms, byte(a.x), byte(a.y))
+	}
+
+	start := referenceState{arms: string(arms)}
+	dist := map[referenceState]int{start: 0}
+	queue := []referenceState{start}
+	for len(queue) > 0 && ctx.Err() == nil {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur.typed == len(code) {
+			return dist[cur]
+		}
+		for _, button := range []byte("^v<>A") {
+			next, ok := referencePress(cur, code, robots, button)
+			if _, seen := dist[next]; ok && !seen {
+				dist[next] = dist[cur] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return 0
+}
+
+func referenceComplexity(ctx context.Context, codes []DoorCode, robots int) int {
+	total := 0
+	for _, code := range codes {
+		total += referencePresses(ctx, code.numeric, robots) * code.numericPart
+	}
+	return total
+}
@@ -1,11 +1,13 @@
-package main
+package day21
 
 import (
-	"bufio"
+	"context"
 	"fmt"
-	"log"
-	"os"
-	"strconv"
+	"io"
+
+	"github.com/reecepm/aoc-2024/memo"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
 )
 
 type Coordinate struct {
@@ -148,14 +150,17 @@ func validatePath(start Coordinate, path string, k *Keypad) bool {
 	return true
 }
 
-func solve(input string, keypads []*Keypad, memo map[MemoKey]MemoValue) (int, bool) {
+func solve(ctx context.Context, input string, keypads []*Keypad, cache *memo.Cache[MemoKey, MemoValue]) (int, bool) {
+	if ctx.Err() != nil {
+		return 0, false
+	}
 	key := MemoKey{input: input, keypads: len(keypads)}
-	if cached, ok := memo[key]; ok {
+	if cached, ok := cache.Get(key); ok {
 		return cached.length, cached.ok
 	}
 
 	if len(keypads) == 0 {
-		memo[key] = MemoValue{length: len(input), ok: true}
+		cache.Put(key, MemoValue{length: len(input), ok: true})
 		return len(input), true
 	}
 
@@ -180,7 +185,7 @@ func solve(input string, keypads []*Keypad, memo map[MemoKey]MemoValue) (int, bo
 				break
 			}
 
-			if subLength, ok := solve(path, keypads[1:], memo); ok {
+			if subLength, ok := solve(ctx, path, keypads[1:], cache); ok {
 				if !found || subLength < shortest {
 					shortest = subLength
 					found = true
@@ -189,7 +194,7 @@ func solve(input string, keypads []*Keypad, memo map[MemoKey]MemoValue) (int, bo
 		}
 
 		if !found {
-			memo[key] = MemoValue{length: 0, ok: false}
+			cache.Put(key, MemoValue{length: 0, ok: false})
 			return 0, false
 		}
 
@@ -197,11 +202,11 @@ func solve(input string, keypads []*Keypad, memo map[MemoKey]MemoValue) (int, bo
 		pos = target
 	}
 
-	memo[key] = MemoValue{length: length, ok: true}
+	cache.Put(key, MemoValue{length: length, ok: true})
 	return length, true
 }
 
-func solveForKeypads(codes []DoorCode, numDirKeypads int) int {
+func solveForKeypads(ctx context.Context, codes []DoorCode, numDirKeypads int) int {
 	numKeypad := NewKeypad(true)
 	var keypads []*Keypad
 	keypads = append(keypads, numKeypad)
@@ -210,54 +215,82 @@ func solveForKeypads(codes []DoorCode, numDirKeypads int) int {
 	}
 
 	total := 0
-	memo := make(map[MemoKey]MemoValue)
+	cache := memo.New[MemoKey, MemoValue](memo.Options{Name: "day21/keypads"})
 	for _, code := range codes {
-		if length, ok := solve(code.numeric, keypads, memo); ok {
+		if length, ok := solve(ctx, code.numeric, keypads, cache); ok {
 			total += length * code.numericPart
 		}
 	}
 	return total
 }
 
-func main() {
-	codes, err := parseInput("input.txt")
-	if err != nil {
-		panic(err)
-	}
+// maxRobots keeps the complexities within int: with 35 robots five of the
+// costliest codes, 957A, add up to about 4.2e18, and one more robot overflows.
+const maxRobots = 35
 
-	p1 := partOne(codes)
-	p2 := partTwo(codes)
+var params = []solver.Param{
+	{Name: "part1-robots", Usage: "directional keypads operated by robots in part one", Default: 2, Max: maxRobots},
+	{Name: "part2-robots", Usage: "directional keypads operated by robots in part two", Default: 25, Max: maxRobots},
+}
 
-	log.Printf("part1: %d", p1)
-	log.Printf("part2: %d", p2)
+type solution struct {
+	codes     []DoorCode
+	params    solver.Params
+	reference bool
 }
 
-func partOne(codes []DoorCode) int {
-	return solveForKeypads(codes, 2)
+func init() {
+	solver.Register(21, func() solver.Solver { return &solution{} })
 }
 
-func partTwo(codes []DoorCode) int {
-	return solveForKeypads(codes, 25)
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.codes, err = parseInput(r)
+	return err
 }
 
-func parseInput(path string) ([]DoorCode, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("opening input file: %w", err)
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referenceComplexity(ctx, s.codes, s.params["part1-robots"])
 	}
-	defer file.Close()
+	return partOne(ctx, s.codes, s.params["part1-robots"])
+}
 
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referenceComplexity(ctx, s.codes, s.params["part2-robots"])
+	}
+	return partTwo(ctx, s.codes, s.params["part2-robots"])
+}
+
+func partOne(ctx context.Context, codes []DoorCode, robots int) int {
+	return solveForKeypads(ctx, codes, robots)
+}
+
+func partTwo(ctx context.Context, codes []DoorCode, robots int) int {
+	return solveForKeypads(ctx, codes, robots)
+}
+
+func parseInput(r io.Reader) ([]DoorCode, error) {
 	var codes []DoorCode
-	scanner := bufio.NewScanner(file)
+	scanner := parse.NewScanner(r)
 	for scanner.Scan() {
 		text := scanner.Text()
 		if text == "" {
 			continue
 		}
 
-		value, err := strconv.Atoi(text[:len(text)-1])
+		if len(text) < 2 || text[len(text)-1] != 'A' {
+			return nil, scanner.Errorf(len(text), "code must be digits followed by A")
+		}
+		value, err := scanner.Int(parse.Field{Text: text[:len(text)-1], Column: 1})
 		if err != nil {
-			return nil, fmt.Errorf("parsing numeric value: %w", err)
+			return nil, err
 		}
 
 		codes = append(codes, DoorCode{
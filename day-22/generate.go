@@ -0,0 +1,22 @@
+package day22
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(22, gen.Generator{Size: "buyers", Default: 2000, Generate: generate, CheckSize: 4, CheckParams: solver.Params{"iterations": 50}})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var b strings.Builder
+	for range max(size, 1) {
+		fmt.Fprintf(&b, "%d\n", 1+rng.IntN(1<<24-1))
+	}
+	return b.String(), nil
+}
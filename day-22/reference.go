@@ -0,0 +1,76 @@
+package day22
+
+import "context"
+
+// referenceNext evolves a secret number by the puzzle's three steps, each a
+// mix into the secret followed by a prune.
+func referenceNext(secret int) int {
+	mix := func(value int) { secret ^= value }
+	prune := func() { secret %= 16777216 }
+	mix(secret * 64)
+	prune()
+	mix(secret / 32)
+	prune()
+	mix(secret * 2048)
+	prune()
+	return secret
+}
+
+// referencePrices returns every price a buyer offers, starting with the one
+// from their initial secret.
+func referencePrices(ctx context.Context, initial, iterations int) []int {
+	prices := []int{initial % 10}
+	for secret := initial; len(prices) <= iterations && ctx.Err() == nil; {
+		secret = referenceNext(secret)
+		prices = append(prices, secret%10)
+	}
+	return prices
+}
+
+func referenceSecrets(ctx context.Context, initials []int, iterations int) int {
+	total := 0
+	for _, secret := range initials {
+		for i := 0; i < iterations && ctx.Err() == nil; i++ {
+			secret = referenceNext(secret)
+		}
+		total += secret
+	}
+	return total
+}
+
+// referenceBestSequence tries every sequence of four changes, scanning each
+// buyer's prices for the first time it appears.
+func referenceBestSequence(ctx context.Context, initials []int, iterations int) int {
+	var prices [][]int
+	for _, initial := range initials {
+		prices = append(prices, referencePrices(ctx, initial, iterations))
+	}
+
+	best := 0
+	var seq [4]int
+	var try func(n int)
+	try = func(n int) {
+		if ctx.Err() != nil {
+			return
+		}
+		if n < len(seq) {
+			for change := -9; change <= 9; change++ {
+				seq[n] = change
+				try(n + 1)
+			}
+			return
+		}
+		bananas := 0
+		for _, p := range prices {
+			for i := 4; i < len(p); i++ {
+				if p[i-3]-p[i-4] == seq[0] && p[i-2]-p[i-3] == seq[1] && p[i-1]-p[i-2] == seq[2] && p[i]-p[i-1] == seq[3] {
+					bananas += p[i]
+					break
+				}
+			}
+		}
+		best = max(best, bananas)
+	}
+	try(0)
+	return best
+}
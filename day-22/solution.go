@@ -0,0 +1,192 @@
+package day22
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Generator struct {
+	secret int
+}
+
+type changeTracker struct {
+	values    []int
+	lastPrice int
+}
+
+func (ct *changeTracker) addChange(newPrice int) {
+	copy(ct.values, ct.values[1:])
+	ct.values[3] = newPrice - ct.lastPrice
+	ct.lastPrice = newPrice
+}
+
+func newChangeTracker(initialPrice int) *changeTracker {
+	return &changeTracker{
+		values:    make([]int, 4),
+		lastPrice: initialPrice,
+	}
+}
+
+func (ct *changeTracker) getKey() string {
+	return fmt.Sprintf("%d,%d,%d,%d", ct.values[0], ct.values[1], ct.values[2], ct.values[3])
+}
+
+type MonkeyMarket struct {
+	initials   []int
+	iterations int
+}
+
+func NewMonkeyMarket(iterations int) *MonkeyMarket {
+	return &MonkeyMarket{
+		initials:   make([]int, 0),
+		iterations: iterations,
+	}
+}
+
+func (m *MonkeyMarket) findBestSequence(ctx context.Context) int {
+	totalBananas := make(map[string]int)
+
+	for _, initial := range m.initials {
+		if ctx.Err() != nil {
+			return 0
+		}
+		gen := NewGenerator(initial)
+		for seq, price := range gen.findSequences(ctx, m.iterations) {
+			totalBananas[seq] += price
+		}
+	}
+
+	maxBananas := 0
+	for _, total := range totalBananas {
+		if total > maxBananas {
+			maxBananas = total
+		}
+	}
+	return maxBananas
+}
+
+func (m *MonkeyMarket) calculateDevicePrice(ctx context.Context) int {
+	total := 0
+	for _, initial := range m.initials {
+		if ctx.Err() != nil {
+			return 0
+		}
+		gen := NewGenerator(initial)
+		for i := 0; i < m.iterations && ctx.Err() == nil; i++ {
+			gen.Next()
+		}
+		total += gen.secret
+	}
+	return total
+}
+
+func NewGenerator(initial int) *Generator {
+	return &Generator{secret: initial}
+}
+
+func (g *Generator) Next() {
+	g.secret = (g.secret ^ (g.secret * 64)) % 16777216
+	g.secret = (g.secret ^ (g.secret / 32)) % 16777216
+	g.secret = (g.secret ^ (g.secret * 2048)) % 16777216
+}
+
+func (g *Generator) findSequences(ctx context.Context, iterations int) map[string]int {
+	seenSequences := make(map[string]bool)
+	sequencePrices := make(map[string]int)
+	tracker := newChangeTracker(g.secret % 10)
+
+	for i := 0; i < 3; i++ {
+		g.Next()
+		tracker.addChange(g.secret % 10)
+	}
+
+	for i := 3; i < iterations && ctx.Err() == nil; i++ {
+		g.Next()
+		price := g.secret % 10
+		tracker.addChange(price)
+
+		key := tracker.getKey()
+		if !seenSequences[key] {
+			seenSequences[key] = true
+			sequencePrices[key] = price
+		}
+	}
+
+	return sequencePrices
+}
+
+// maxIterations is one more than the secrets' period of 2^24-1, so that a
+// buyer offers every price it ever will within it.
+const maxIterations = 1 << 24
+
+var params = []solver.Param{
+	{Name: "iterations", Usage: "secret numbers each buyer generates", Default: 2000, Max: maxIterations},
+}
+
+type solution struct {
+	market    *MonkeyMarket
+	params    solver.Params
+	reference bool
+}
+
+func init() {
+	solver.Register(22, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.market, err = parseInput(r, s.params["iterations"])
+	return err
+}
+
+func (s *solution) Params() []solver.Param    { return params }
+func (s *solution) SetParams(p solver.Params) { s.params = p }
+
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referenceSecrets(ctx, s.market.initials, s.market.iterations)
+	}
+	return partOne(ctx, s.market)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referenceBestSequence(ctx, s.market.initials, s.market.iterations)
+	}
+	return partTwo(ctx, s.market)
+}
+
+func partOne(ctx context.Context, market *MonkeyMarket) int {
+	return market.calculateDevicePrice(ctx)
+}
+
+func partTwo(ctx context.Context, market *MonkeyMarket) int {
+	return market.findBestSequence(ctx)
+}
+
+func parseInput(r io.Reader, iterations int) (*MonkeyMarket, error) {
+	market := NewMonkeyMarket(iterations)
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			num, err := scanner.Int(parse.Field{Text: line, Column: 1})
+			if err != nil {
+				return nil, err
+			}
+			market.initials = append(market.initials, num)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning input: %w", err)
+	}
+
+	return market, nil
+}
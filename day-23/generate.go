@@ -0,0 +1,59 @@
+package day23
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(23, gen.Generator{Size: "computers", Default: 520, Generate: generate, CheckSize: 16})
+}
+
+// generate links each computer to a handful of random others and hides a
+// LAN party of up to thirteen among them. Computers outside the party get
+// too few links to be in a group as large, so the party is the only answer.
+// Size is capped at the 676 two-letter names.
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	size = min(max(size, 3), 26*26)
+	names := make([]string, size)
+	for i, n := range rng.Perm(26 * 26)[:size] {
+		names[i] = string([]byte{byte('a' + n/26), byte('a' + n%26)})
+	}
+
+	type link struct{ a, b int }
+	party := min(13, size)
+	linked := make(map[link]bool)
+	degree := make([]int, size)
+	var links []string
+	connect := func(a, b int) {
+		if a == b || linked[link{a, b}] {
+			return
+		}
+		if (a >= party && degree[a] >= party-2) || (b >= party && degree[b] >= party-2) {
+			return
+		}
+		linked[link{a, b}], linked[link{b, a}] = true, true
+		degree[a]++
+		degree[b]++
+		if rng.IntN(2) == 0 {
+			a, b = b, a
+		}
+		links = append(links, names[a]+"-"+names[b])
+	}
+
+	for a := range party {
+		for b := a + 1; b < party; b++ {
+			connect(a, b)
+		}
+	}
+	for a := range size {
+		for range 6 {
+			connect(a, rng.IntN(size))
+		}
+	}
+	rng.Shuffle(len(links), func(i, j int) { links[i], links[j] = links[j], links[i] })
+	return strings.Join(links, "\n") + "\n", nil
+}
@@ -0,0 +1,79 @@
+package day23
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+func referenceComputers(n *Network) ([]string, map[[2]string]bool) {
+	var computers []string
+	linked := make(map[[2]string]bool)
+	for comp, conns := range n.connections {
+		computers = append(computers, comp)
+		for _, conn := range conns {
+			linked[[2]string{comp, conn}] = true
+		}
+	}
+	sort.Strings(computers)
+	return computers, linked
+}
+
+// referenceTriples checks every set of three computers.
+func referenceTriples(ctx context.Context, n *Network) int {
+	computers, linked := referenceComputers(n)
+	count := 0
+	for i, a := range computers {
+		if ctx.Err() != nil {
+			return 0
+		}
+		for j := i + 1; j < len(computers); j++ {
+			for k := j + 1; k < len(computers); k++ {
+				b, c := computers[j], computers[k]
+				if !linked[[2]string{a, b}] || !linked[[2]string{b, c}] || !linked[[2]string{a, c}] {
+					continue
+				}
+				if a[0] == 't' || b[0] == 't' || c[0] == 't' {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+// referenceLargestGroup checks every subset of the computers, so only suits
+// networks of twenty or so. Of equally large groups it returns the password
+// that sorts first.
+func referenceLargestGroup(ctx context.Context, n *Network) string {
+	computers, linked := referenceComputers(n)
+	var best []string
+	var password string
+	for set := 1; set < 1<<len(computers); set++ {
+		if ctx.Err() != nil {
+			return ""
+		}
+		var group []string
+		for i, comp := range computers {
+			if set&(1<<i) != 0 {
+				group = append(group, comp)
+			}
+		}
+		if len(group) < len(best) {
+			continue
+		}
+		connected := true
+		for i := range group {
+			for j := i + 1; j < len(group) && connected; j++ {
+				connected = linked[[2]string{group[i], group[j]}]
+			}
+		}
+		if !connected {
+			continue
+		}
+		if p := strings.Join(group, ","); len(group) > len(best) || p < password {
+			best, password = group, p
+		}
+	}
+	return password
+}
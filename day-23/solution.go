@@ -1,12 +1,13 @@
-package main
+package day23
 
 import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
+	"context"
+	"io"
 	"sort"
 	"strings"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
 )
 
 type Network struct {
@@ -24,11 +25,14 @@ func (n *Network) addConnection(from, to string) {
 	n.connections[to] = append(n.connections[to], from)
 }
 
-func (n *Network) findTriples() [][]string {
+func (n *Network) findTriples(ctx context.Context) [][]string {
 	var triples [][]string
 	seen := make(map[string]bool)
 
 	for computer := range n.connections {
+		if ctx.Err() != nil {
+			return nil
+		}
 		for _, conn1 := range n.connections[computer] {
 			for _, conn2 := range n.connections[conn1] {
 				triple := []string{computer, conn1, conn2}
@@ -49,9 +53,9 @@ func (n *Network) findTriples() [][]string {
 	return triples
 }
 
-func (n *Network) findTriplesWithT() [][]string {
+func (n *Network) findTriplesWithT(ctx context.Context) [][]string {
 	var result [][]string
-	for _, triple := range n.findTriples() {
+	for _, triple := range n.findTriples(ctx) {
 		for _, computer := range triple {
 			if strings.HasPrefix(computer, "t") {
 				result = append(result, triple)
@@ -62,7 +66,7 @@ func (n *Network) findTriplesWithT() [][]string {
 	return result
 }
 
-func (n *Network) findLargestConnectedGroup() []string {
+func (n *Network) findLargestConnectedGroup(ctx context.Context) []string {
 	connections := make(map[string]map[string]bool)
 	for comp, conns := range n.connections {
 		connections[comp] = make(map[string]bool)
@@ -78,6 +82,9 @@ func (n *Network) findLargestConnectedGroup() []string {
 	}
 
 	for _, start := range computers {
+		if ctx.Err() != nil {
+			return nil
+		}
 		group := []string{start}
 		var candidates []string
 		for neighbor := range connections[start] {
@@ -122,35 +129,64 @@ func makeTripleKey(triple []string) string {
 	return strings.Join(triple, ",")
 }
 
-func main() {
-	network, err := parseInput("input.txt")
-	if err != nil {
-		panic(err)
-	}
+type solution struct {
+	mode      parse.Mode
+	network   *Network
+	reference bool
+}
 
-	p1 := len(network.findTriplesWithT())
-	p2 := strings.Join(network.findLargestConnectedGroup(), ",")
+func init() {
+	solver.Register(23, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.network, err = parseInput(r, s.mode)
+	return err
+}
 
-	log.Printf("part1: %d", p1)
-	log.Printf("part2: %s", p2)
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referenceTriples(ctx, s.network)
+	}
+	return partOne(ctx, s.network)
 }
 
-func parseInput(path string) (*Network, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("opening input file: %w", err)
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referenceLargestGroup(ctx, s.network)
 	}
-	defer file.Close()
+	return partTwo(ctx, s.network)
+}
 
+func partOne(ctx context.Context, n *Network) int {
+	return len(n.findTriplesWithT(ctx))
+}
+
+func partTwo(ctx context.Context, n *Network) string {
+	return strings.Join(n.findLargestConnectedGroup(ctx), ",")
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*Network, error) {
 	network := NewNetwork()
-	scanner := bufio.NewScanner(file)
+	scanner := parse.NewScanner(r)
 
 	for scanner.Scan() {
 		if line := scanner.Text(); line != "" {
-			parts := strings.Split(line, "-")
-			if len(parts) == 2 {
-				network.addConnection(parts[0], parts[1])
+			parts := parse.Split(line, "-")
+			if len(parts) != 2 || parts[0].Text == "" || parts[1].Text == "" {
+				if mode == parse.Lenient {
+					continue
+				}
+				return nil, scanner.Errorf(0, "expected \"a-b\", got %q", line)
+			}
+			if parts[0].Text == parts[1].Text && mode == parse.Strict {
+				return nil, scanner.Errorf(parts[1].Column, "computer %q connected to itself", parts[0].Text)
 			}
+			network.addConnection(parts[0].Text, parts[1].Text)
 		}
 	}
 
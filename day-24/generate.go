@@ -0,0 +1,90 @@
+package day24
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(24, gen.Generator{Size: "bits added", Default: 45, Generate: generate, CheckSize: 4})
+}
+
+// swappable are the pairs of gates in a bit whose outputs the real inputs
+// swap: the sum with the carry's AND, the input AND or the carry out, and the
+// input XOR with the input AND.
+var swappable = [][2]int{{2, 3}, {2, 1}, {2, 4}, {0, 1}}
+
+// generate wires a ripple-carry adder of size bits and then swaps four pairs
+// of gate outputs, each within the gates of one bit, like the real input.
+// Size is capped so that the sum fits in an int.
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	bits := min(max(size, 2), 62)
+
+	used := make(map[string]bool)
+	wire := func() string {
+		for {
+			name := string([]byte{byte('a' + rng.IntN(23)), byte('a' + rng.IntN(26)), byte('a' + rng.IntN(26))})
+			if !used[name] {
+				used[name] = true
+				return name
+			}
+		}
+	}
+
+	type gate struct{ in1, op, in2, out string }
+	var gates []*gate
+	add := func(in1, op, in2, out string) *gate {
+		if rng.IntN(2) == 0 {
+			in1, in2 = in2, in1
+		}
+		g := &gate{in1, op, in2, out}
+		gates = append(gates, g)
+		return g
+	}
+
+	// cells holds the gates of each bit, whose outputs may be swapped.
+	cells := make([][]*gate, bits)
+	carry := wire()
+	add("x00", "XOR", "y00", "z00")
+	add("x00", "AND", "y00", carry)
+	for i := 1; i < bits; i++ {
+		x, y, z := fmt.Sprintf("x%02d", i), fmt.Sprintf("y%02d", i), fmt.Sprintf("z%02d", i)
+		half, both, through := wire(), wire(), wire()
+		next := fmt.Sprintf("z%02d", bits)
+		if i < bits-1 {
+			next = wire()
+		}
+		cells[i] = []*gate{
+			add(x, "XOR", y, half),
+			add(x, "AND", y, both),
+			add(half, "XOR", carry, z),
+			add(half, "AND", carry, through),
+			add(through, "OR", both, next),
+		}
+		carry = next
+	}
+
+	swaps := min(4, bits-2)
+	for _, i := range rng.Perm(bits - 2)[:swaps] {
+		pair := swappable[rng.IntN(len(swappable))]
+		a, b := cells[i+1][pair[0]], cells[i+1][pair[1]]
+		a.out, b.out = b.out, a.out
+	}
+
+	var sb strings.Builder
+	for _, reg := range "xy" {
+		for i := range bits {
+			fmt.Fprintf(&sb, "%c%02d: %d\n", reg, i, rng.IntN(2))
+		}
+	}
+	sb.WriteByte('\n')
+	rng.Shuffle(len(gates), func(i, j int) { gates[i], gates[j] = gates[j], gates[i] })
+	for _, g := range gates {
+		fmt.Fprintf(&sb, "%s %s %s -> %s\n", g.in1, g.op, g.in2, g.out)
+	}
+	return sb.String(), nil
+}
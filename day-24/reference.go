@@ -0,0 +1,153 @@
+package day24
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// referenceCircuit evaluates wires by recursing through the gates that drive
+// them, with the gates' outputs free to be swapped.
+type referenceCircuit struct {
+	gates  []*Gate
+	driver map[string]int
+	inputs map[string]int
+}
+
+func newReferenceCircuit(c *Circuit) *referenceCircuit {
+	rc := &referenceCircuit{gates: c.gates, driver: make(map[string]int), inputs: make(map[string]int)}
+	for i, g := range c.gates {
+		rc.driver[g.output] = i
+	}
+	for wire, value := range c.wireValues {
+		if _, driven := rc.driver[wire]; !driven {
+			rc.inputs[wire] = value
+		}
+	}
+	return rc
+}
+
+// value returns a wire's value, or false when it has no value or depends on
+// itself.
+func (rc *referenceCircuit) value(wire string, values map[string]int, visiting map[string]bool) (int, bool) {
+	if v, ok := values[wire]; ok {
+		return v, true
+	}
+	i, ok := rc.driver[wire]
+	if !ok || visiting[wire] {
+		return 0, false
+	}
+	visiting[wire] = true
+	defer delete(visiting, wire)
+
+	g := rc.gates[i]
+	in1, ok1 := rc.value(g.input1, values, visiting)
+	in2, ok2 := rc.value(g.input2, values, visiting)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	var v int
+	switch g.gateType {
+	case "AND":
+		v = in1 & in2
+	case "OR":
+		v = in1 | in2
+	case "XOR":
+		v = in1 ^ in2
+	}
+	values[wire] = v
+	return v, true
+}
+
+// output reads the z wires with the inputs given, or false when one of them
+// has no value.
+func (rc *referenceCircuit) output(inputs map[string]int) (int, bool) {
+	values := make(map[string]int, len(inputs))
+	for wire, v := range inputs {
+		values[wire] = v
+	}
+	result := 0
+	for i := 0; ; i++ {
+		wire := fmt.Sprintf("z%02d", i)
+		if _, ok := rc.driver[wire]; !ok {
+			return result, true
+		}
+		v, ok := rc.value(wire, values, make(map[string]bool))
+		if !ok {
+			return 0, false
+		}
+		result |= v << i
+	}
+}
+
+func referenceOutput(c *Circuit) int {
+	rc := newReferenceCircuit(c)
+	result, _ := rc.output(rc.inputs)
+	return result
+}
+
+// adds reports whether the circuit adds every pair of bits-bit numbers.
+func (rc *referenceCircuit) adds(bits int) bool {
+	inputs := make(map[string]int)
+	for x := range 1 << bits {
+		for y := range 1 << bits {
+			for i := range bits {
+				inputs[fmt.Sprintf("x%02d", i)] = x >> i & 1
+				inputs[fmt.Sprintf("y%02d", i)] = y >> i & 1
+			}
+			if sum, ok := rc.output(inputs); !ok || sum != x+y {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// referenceSwaps tries ever more swaps of gate outputs, up to four, until the
+// circuit adds every pair of inputs. It tries every input, so only suits
+// adders of a few bits.
+func referenceSwaps(ctx context.Context, c *Circuit) string {
+	rc := newReferenceCircuit(c)
+	bits := 0
+	for wire := range rc.inputs {
+		if strings.HasPrefix(wire, "x") {
+			bits++
+		}
+	}
+
+	var swapped []string
+	var try func(from, swaps int) bool
+	try = func(from, swaps int) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		if swaps == 0 {
+			return rc.adds(bits)
+		}
+		for i := from; i < len(rc.gates); i++ {
+			for j := i + 1; j < len(rc.gates); j++ {
+				a, b := rc.gates[i].output, rc.gates[j].output
+				if rc.driver[a] != i || rc.driver[b] != j {
+					continue
+				}
+				rc.driver[a], rc.driver[b] = j, i
+				found := try(i+1, swaps-1)
+				rc.driver[a], rc.driver[b] = i, j
+				if found {
+					swapped = append(swapped, a, b)
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for swaps := 0; swaps <= 4 && ctx.Err() == nil; swaps++ {
+		if try(0, swaps) {
+			sort.Strings(swapped)
+			return strings.Join(swapped, ",")
+		}
+	}
+	return ""
+}
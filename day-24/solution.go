@@ -1,12 +1,14 @@
-package main
+package day24
 
 import (
-	"bufio"
+	"context"
 	"fmt"
-	"log"
-	"os"
+	"io"
 	"sort"
 	"strings"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
 )
 
 type Gate struct {
@@ -42,12 +44,12 @@ func (c *Circuit) setWireValue(wire string, value int) {
 	c.wireValues[wire] = value
 }
 
-func (c *Circuit) evaluate() {
+func (c *Circuit) evaluate(ctx context.Context) {
 	for _, gate := range c.gates {
 		gate.evaluated = false
 	}
 
-	for {
+	for ctx.Err() == nil {
 		progress := false
 		allEvaluated := true
 
@@ -111,6 +113,7 @@ func (c *Circuit) getResult() int {
 
 func (c *Circuit) findBrokenConnections() string {
 	inputMap := c.buildInputMap()
+	last := c.lastOutput()
 	broken := make(map[string]bool)
 
 	for _, gate := range c.gates {
@@ -120,7 +123,7 @@ func (c *Circuit) findBrokenConnections() string {
 				broken[gate.output] = true
 			}
 		case "OR":
-			if c.isOutputWire(gate.output) && gate.output != "z45" {
+			if c.isOutputWire(gate.output) && gate.output != last {
 				broken[gate.output] = true
 			}
 		case "XOR":
@@ -146,6 +149,18 @@ func (c *Circuit) buildInputMap() map[string]bool {
 	return m
 }
 
+// lastOutput returns the highest output wire, which carries the adder's final
+// carry straight from an OR gate.
+func (c *Circuit) lastOutput() string {
+	last := ""
+	for _, g := range c.gates {
+		if c.isOutputWire(g.output) && (len(g.output) > len(last) || len(g.output) == len(last) && g.output > last) {
+			last = g.output
+		}
+	}
+	return last
+}
+
 func (c *Circuit) isFirstAND(g *Gate) bool {
 	return g.input1 == "x00" || g.input2 == "x00"
 }
@@ -175,21 +190,41 @@ func makeKey(wire, gateType string) string {
 	return fmt.Sprintf("%s,%s", wire, gateType)
 }
 
-func main() {
-	circuit, err := parseInput("input.txt")
-	if err != nil {
-		panic(err)
-	}
+type solution struct {
+	mode      parse.Mode
+	circuit   *Circuit
+	reference bool
+}
+
+func init() {
+	solver.Register(24, func() solver.Solver { return &solution{} })
+}
 
-	p1 := partOne(circuit)
-	p2 := partTwo(circuit)
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
 
-	log.Printf("part1: %d", p1)
-	log.Printf("part2: %s", p2)
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.circuit, err = parseInput(r, s.mode)
+	return err
 }
 
-func partOne(c *Circuit) int {
-	c.evaluate()
+func (s *solution) PartOne(ctx context.Context) any {
+	if s.reference {
+		return referenceOutput(s.circuit)
+	}
+	return partOne(ctx, s.circuit)
+}
+
+func (s *solution) PartTwo(ctx context.Context) any {
+	if s.reference {
+		return referenceSwaps(ctx, s.circuit)
+	}
+	return partTwo(s.circuit)
+}
+
+func partOne(ctx context.Context, c *Circuit) int {
+	c.evaluate(ctx)
 	return c.getResult()
 }
 
@@ -197,15 +232,9 @@ func partTwo(c *Circuit) string {
 	return c.findBrokenConnections()
 }
 
-func parseInput(path string) (*Circuit, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("opening input file: %w", err)
-	}
-	defer file.Close()
-
+func parseInput(r io.Reader, mode parse.Mode) (*Circuit, error) {
 	circuit := NewCircuit()
-	scanner := bufio.NewScanner(file)
+	scanner := parse.NewScanner(r)
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -213,14 +242,9 @@ func parseInput(path string) (*Circuit, error) {
 			break
 		}
 
-		parts := strings.Split(line, ": ")
-		if len(parts) != 2 {
-			continue
+		if err := parseWire(scanner, circuit); err != nil && mode == parse.Strict {
+			return nil, err
 		}
-
-		value := 0
-		fmt.Sscanf(parts[1], "%d", &value)
-		circuit.setWireValue(parts[0], value)
 	}
 
 	for scanner.Scan() {
@@ -229,18 +253,45 @@ func parseInput(path string) (*Circuit, error) {
 			continue
 		}
 
-		parts := strings.Split(line, " -> ")
-		if len(parts) != 2 {
-			continue
+		if err := parseGate(scanner, circuit); err != nil && mode == parse.Strict {
+			return nil, err
 		}
+	}
 
-		gateParts := strings.Split(parts[0], " ")
-		if len(gateParts) != 3 {
-			continue
-		}
+	return circuit, scanner.Err()
+}
 
-		circuit.addGate(gateParts[1], gateParts[0], gateParts[2], parts[1])
+func parseWire(scanner *parse.Scanner, circuit *Circuit) error {
+	parts := parse.Split(scanner.Text(), ": ")
+	if len(parts) != 2 || parts[0].Text == "" {
+		return scanner.Errorf(0, "expected \"wire: value\"")
 	}
 
-	return circuit, scanner.Err()
+	if parts[1].Text != "0" && parts[1].Text != "1" {
+		return scanner.Errorf(parts[1].Column, "wire value must be 0 or 1, got %q", parts[1].Text)
+	}
+
+	circuit.setWireValue(parts[0].Text, int(parts[1].Text[0]-'0'))
+	return nil
+}
+
+func parseGate(scanner *parse.Scanner, circuit *Circuit) error {
+	parts := parse.Split(scanner.Text(), " -> ")
+	if len(parts) != 2 || parts[1].Text == "" {
+		return scanner.Errorf(0, "expected \"a OP b -> out\"")
+	}
+
+	gateParts := parse.Split(parts[0].Text, " ")
+	if len(gateParts) != 3 || gateParts[0].Text == "" || gateParts[2].Text == "" {
+		return scanner.Errorf(0, "expected \"a OP b -> out\"")
+	}
+
+	switch gateParts[1].Text {
+	case "AND", "OR", "XOR":
+	default:
+		return scanner.Errorf(gateParts[1].Column, "unknown gate %q", gateParts[1].Text)
+	}
+
+	circuit.addGate(gateParts[1].Text, gateParts[0].Text, gateParts[2].Text, parts[1].Text)
+	return nil
 }
@@ -0,0 +1,31 @@
+package day25
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func init() {
+	gen.Register(25, gen.Generator{Size: "locks and keys", Default: 500, Generate: generate, CheckSize: 20})
+}
+
+func generate(rng *rand.Rand, size int) (string, solver.Params) {
+	var schematics []string
+	for range max(size, 2) {
+		lock := rng.IntN(2) == 0
+		g := gen.NewGrid(5, 7, '.')
+		for x := range 5 {
+			height := rng.IntN(6)
+			for y := range 7 {
+				if (lock && y <= height) || (!lock && y >= 6-height) {
+					g[y][x] = '#'
+				}
+			}
+		}
+		schematics = append(schematics, g.String())
+	}
+	return strings.Join(schematics, "\n"), nil
+}
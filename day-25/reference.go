@@ -0,0 +1,34 @@
+package day25
+
+import "strings"
+
+// referenceFits overlays every lock with every key, counting the pairs that
+// never fill the same cell.
+func referenceFits(lk *LockAndKey) int {
+	var locks, keys [][]string
+	for _, rows := range lk.schematics {
+		filled := strings.Repeat("#", len(rows[0]))
+		switch {
+		case rows[0] == filled:
+			locks = append(locks, rows)
+		case rows[len(rows)-1] == filled:
+			keys = append(keys, rows)
+		}
+	}
+
+	count := 0
+	for _, lock := range locks {
+		for _, key := range keys {
+			fits := len(lock) == len(key)
+			for y := 0; y < len(lock) && fits; y++ {
+				for x := 0; x < len(lock[y]) && fits; x++ {
+					fits = lock[y][x] != '#' || key[y][x] != '#'
+				}
+			}
+			if fits {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,205 @@
+package day25
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+type Pin struct {
+	heights []int
+}
+
+type LockAndKey struct {
+	locks []Pin
+	keys  []Pin
+	// schematics keeps the rows of every lock and key for the reference
+	// solver.
+	schematics [][]string
+}
+
+func NewLockAndKey() *LockAndKey {
+	return &LockAndKey{
+		locks: make([]Pin, 0),
+		keys:  make([]Pin, 0),
+	}
+}
+
+func (lk *LockAndKey) addLock(heights []int) {
+	lk.locks = append(lk.locks, Pin{heights: heights})
+}
+
+func (lk *LockAndKey) addKey(heights []int) {
+	lk.keys = append(lk.keys, Pin{heights: heights})
+}
+
+func (lk *LockAndKey) countValidPairs() int {
+	count := 0
+	for _, lock := range lk.locks {
+		for _, key := range lk.keys {
+			if lk.isValidPair(lock, key) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (lk *LockAndKey) isValidPair(lock, key Pin) bool {
+	if len(lock.heights) != len(key.heights) {
+		return false
+	}
+
+	for i := 0; i < len(lock.heights); i++ {
+		if lock.heights[i]+key.heights[i] > 5 {
+			return false
+		}
+	}
+	return true
+}
+
+func calculateLockHeights(lines []string) []int {
+	var heights []int
+	width := len(lines[0])
+
+	for col := 0; col < width; col++ {
+		height := 0
+		for row := 0; row < len(lines); row++ {
+			if lines[row][col] == '#' {
+				height++
+			} else {
+				break
+			}
+		}
+		heights = append(heights, height-1)
+		if heights[len(heights)-1] < 0 {
+			heights[len(heights)-1] = 0
+		}
+	}
+	return heights
+}
+
+func calculateKeyHeights(lines []string) []int {
+	var heights []int
+	width := len(lines[0])
+
+	for col := 0; col < width; col++ {
+		height := 0
+		for row := len(lines) - 1; row >= 0; row-- {
+			if lines[row][col] == '#' {
+				height++
+			} else {
+				break
+			}
+		}
+		heights = append(heights, height-1)
+		if heights[len(heights)-1] < 0 {
+			heights[len(heights)-1] = 0
+		}
+	}
+	return heights
+}
+
+func parseInput(r io.Reader, mode parse.Mode) (*LockAndKey, error) {
+	scanner := parse.NewScanner(r)
+	lk := NewLockAndKey()
+
+	var currentSchematic []string
+	start := 0
+
+	flush := func() error {
+		if len(currentSchematic) == 0 {
+			return nil
+		}
+		err := addSchematic(lk, currentSchematic, start)
+		currentSchematic = nil
+		if mode == parse.Lenient {
+			return nil
+		}
+		return err
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if len(currentSchematic) == 0 {
+			start = scanner.Line()
+		}
+		currentSchematic = append(currentSchematic, line)
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return lk, scanner.Err()
+}
+
+// addSchematic files a schematic starting on the given input line as a lock
+// when its top row is filled and as a key when its bottom row is.
+func addSchematic(lk *LockAndKey, lines []string, start int) error {
+	width := len(lines[0])
+	for i, line := range lines {
+		if len(line) != width {
+			return parse.Errorf(start+i, min(len(line), width)+1, "row has width %d, want %d", len(line), width)
+		}
+		if col := strings.IndexFunc(line, func(r rune) bool { return r != '#' && r != '.' }); col >= 0 {
+			return parse.Errorf(start+i, col+1, "unexpected character %q", line[col])
+		}
+	}
+
+	filled := strings.Repeat("#", width)
+	lk.schematics = append(lk.schematics, lines)
+	switch {
+	case lines[0] == filled:
+		lk.addLock(calculateLockHeights(lines))
+	case lines[len(lines)-1] == filled:
+		lk.addKey(calculateKeyHeights(lines))
+	default:
+		return parse.Errorf(start, 0, "schematic is neither a lock nor a key")
+	}
+	return nil
+}
+
+type solution struct {
+	mode      parse.Mode
+	lk        *LockAndKey
+	reference bool
+}
+
+func init() {
+	solver.Register(25, func() solver.Solver { return &solution{} })
+}
+
+func (s *solution) SetMode(m parse.Mode) { s.mode = m }
+func (s *solution) SetReference(on bool) { s.reference = on }
+
+func (s *solution) Parse(r io.Reader) error {
+	var err error
+	s.lk, err = parseInput(r, s.mode)
+	return err
+}
+
+func (s *solution) PartOne(context.Context) any {
+	if s.reference {
+		return referenceFits(s.lk)
+	}
+	return partOne(s.lk)
+}
+
+// PartTwo has no puzzle on the final day.
+func (s *solution) PartTwo(context.Context) any { return nil }
+
+func partOne(lk *LockAndKey) int {
+	return lk.countValidPairs()
+}
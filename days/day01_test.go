@@ -0,0 +1,198 @@
+package days
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	day01 "github.com/reecepm/aoc-2024/day-01"
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+// TestDay01Stream compares streaming day 01 through runs on disk with solving
+// it in memory, with runs small enough that they are merged in rounds.
+func TestDay01Stream(t *testing.T) {
+	for _, size := range []int{1, 5, 200} {
+		in, err := gen.New(1, uint64(size), size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := solver.Run(context.Background(), 1, bytes.NewReader(in.Data), solver.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := day01.Totals{Distance: res.PartOne.(int), Similarity: res.PartTwo.(int)}
+
+		for _, opts := range []day01.StreamOptions{{RunSize: 1000}, {RunSize: 7, FanIn: 64}, {RunSize: 3, FanIn: 2}} {
+			t.Run(fmt.Sprintf("size%d/run%d/fan%d", size, opts.RunSize, opts.FanIn), func(t *testing.T) {
+				opts.Dir = t.TempDir()
+				got, err := day01.Stream(bytes.NewReader(in.Data), parse.Strict, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != want {
+					t.Errorf("Stream = %+v, want %+v", got, want)
+				}
+				if left, _ := os.ReadDir(opts.Dir); len(left) > 0 {
+					t.Errorf("left %d temporary files behind", len(left))
+				}
+			})
+		}
+	}
+}
+
+func TestDay01StreamParam(t *testing.T) {
+	res, err := solver.Run(context.Background(), 1, bytes.NewReader([]byte("3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n")),
+		solver.Options{Params: solver.Params{"stream-run": 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PartOne != 11 || res.PartTwo != 31 {
+		t.Errorf("answers = %v, %v; want 11, 31", res.PartOne, res.PartTwo)
+	}
+}
+
+func TestDay01StreamMalformed(t *testing.T) {
+	input := "3   4\n4   x\n2   5\n"
+	if _, err := day01.Stream(bytes.NewReader([]byte(input)), parse.Strict, day01.StreamOptions{Dir: t.TempDir()}); err == nil {
+		t.Error("strict Stream accepted a malformed line")
+	}
+	got, err := day01.Stream(bytes.NewReader([]byte(input)), parse.Lenient, day01.StreamOptions{Dir: t.TempDir()})
+	if err != nil || got != (day01.Totals{Distance: 4}) {
+		t.Errorf("lenient Stream = %+v, %v; want distance 4", got, err)
+	}
+}
+
+// TestDay01SimilarityIndex applies random edits to an index and checks its
+// answers against solving the lists from scratch after each one.
+func TestDay01SimilarityIndex(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 1))
+	lists := [2][]int{}
+	for side := range lists {
+		for range 50 {
+			lists[side] = append(lists[side], rng.IntN(40))
+		}
+	}
+	idx := day01.NewSimilarityIndex(lists[0], lists[1])
+
+	for op := range 5000 {
+		side := rng.IntN(2)
+		id := rng.IntN(40)
+		if rng.IntN(2) == 0 {
+			id = rng.IntN(1000) - 500
+		}
+		if rng.IntN(2) == 0 && len(lists[side]) > 0 {
+			i := rng.IntN(len(lists[side]))
+			id = lists[side][i]
+			lists[side] = slices.Delete(lists[side], i, i+1)
+			if !idx.Remove(day01.Side(side), id) {
+				t.Fatalf("op %d: Remove(%d, %d) = false", op, side, id)
+			}
+		} else {
+			lists[side] = append(lists[side], id)
+			idx.Insert(day01.Side(side), id)
+		}
+
+		if got, want := idx.Similarity(), day01Similarity(lists[0], lists[1]); got != want {
+			t.Fatalf("op %d: Similarity = %d, want %d", op, got, want)
+		}
+		got, ok := idx.Distance()
+		if ok != (len(lists[0]) == len(lists[1])) {
+			t.Fatalf("op %d: Distance ok = %v with lists of %d and %d", op, ok, len(lists[0]), len(lists[1]))
+		}
+		if ok {
+			if want := day01Distance(t, lists[0], lists[1]); got != want {
+				t.Fatalf("op %d: Distance = %d, want %d", op, got, want)
+			}
+		}
+	}
+
+	if idx.Remove(day01.Left, 10000) {
+		t.Error("removed an ID that is not in the list")
+	}
+}
+
+// day01Distance solves part one of day 01 for two lists of equal length from
+// scratch.
+func day01Distance(t *testing.T, left, right []int) int {
+	t.Helper()
+	var b strings.Builder
+	for i := range left {
+		fmt.Fprintf(&b, "%d   %d\n", left[i], right[i])
+	}
+	res, err := solver.Run(context.Background(), 1, strings.NewReader(b.String()), solver.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.PartOne.(int)
+}
+
+func day01Similarity(left, right []int) int {
+	total := 0
+	for _, a := range left {
+		for _, b := range right {
+			if a == b {
+				total += a
+			}
+		}
+	}
+	return total
+}
+
+func TestDay01Reconcile(t *testing.T) {
+	input := "3 4 3\n4 3 3\n2 5 5\n1 3 1\n3 9 3\n3 3 3\n"
+	lists, err := day01.ParseLists(strings.NewReader(input), parse.Strict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := day01.Reconcile(lists)
+
+	// The first two columns are the puzzle's example.
+	if rec.Distances[0][1] != 11 || rec.Distances[1][0] != 11 || rec.Similarities[0][1] != 31 {
+		t.Errorf("lists 1 and 2: distance %d, similarity %d; want 11, 31", rec.Distances[0][1], rec.Similarities[0][1])
+	}
+	for i := range lists {
+		if rec.Distances[i][i] != 0 {
+			t.Errorf("list %d is %d away from itself", i+1, rec.Distances[i][i])
+		}
+	}
+
+	want := []day01.Discrepancies{
+		{Missing: []int{5, 9}},
+		{Missing: []int{1, 2}},
+		{Missing: []int{2, 4, 9}, Excess: []day01.Excess{{ID: 3, Count: 4, Others: 3}}},
+	}
+	for i := range want {
+		got := rec.Discrepancies[i]
+		if !slices.Equal(got.Missing, want[i].Missing) || !slices.Equal(got.Excess, want[i].Excess) {
+			t.Errorf("list %d discrepancies = %+v, want %+v", i+1, got, want[i])
+		}
+	}
+
+	if _, err := day01.ParseLists(strings.NewReader("1 2 3\n4 5\n"), parse.Strict); err == nil {
+		t.Error("ParseLists accepted a row with too few columns")
+	}
+}
+
+func TestDay01Report(t *testing.T) {
+	s, err := solver.New(1, solver.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := s.(solver.Reporter).Report(strings.NewReader("1 1 2\n2 1 2\n"), &b); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"list 3", "missing: 1", "over-represented: 1 (2 here, at most 1 elsewhere)"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("report lacks %q:\n%s", want, b.String())
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package days
+
+import (
+	"context"
+	"math/rand/v2"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	day02 "github.com/reecepm/aoc-2024/day-02"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func TestDay02Diagnose(t *testing.T) {
+	tests := []struct {
+		levels    []int
+		violation int
+		rule      day02.Rule
+		step      int
+		fixes     []int
+	}{
+		{[]int{7, 6, 4, 2, 1}, -1, day02.NoRule, 0, nil},
+		{[]int{1, 2, 7, 8, 9}, 2, day02.StepOutOfRange, 5, nil},
+		{[]int{9, 7, 6, 2, 1}, 3, day02.StepOutOfRange, -4, nil},
+		{[]int{1, 3, 2, 4, 5}, 2, day02.DirectionChange, -1, []int{1, 2}},
+		{[]int{8, 6, 4, 4, 1}, 3, day02.StepOutOfRange, 0, []int{2, 3}},
+		{[]int{5, 5, 6}, 1, day02.StepOutOfRange, 0, []int{0, 1}},
+		{[]int{1, 5, 4, 3}, 1, day02.StepOutOfRange, 4, []int{0}},
+		{[]int{3}, -1, day02.NoRule, 0, nil},
+	}
+
+	for _, tt := range tests {
+		d := day02.Diagnose(tt.levels)
+		if d.Safe != (tt.violation < 0) || d.Violation != tt.violation || d.Rule != tt.rule || d.Step != tt.step || !slices.Equal(d.Fixes, tt.fixes) {
+			t.Errorf("Diagnose(%v) = %+v, want violation %d (%v, step %d), fixes %v",
+				tt.levels, d, tt.violation, tt.rule, tt.step, tt.fixes)
+		}
+	}
+}
+
+func TestDay02Report(t *testing.T) {
+	s, err := solver.New(2, solver.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := solver.OpenInput(filepath.Join("..", solver.InputPath(2, true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var b strings.Builder
+	if err := s.(solver.Reporter).Report(f, &b); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"direction change", "remove level 2 (3) or 3 (2)", "6 reports: 2 safe, 2 safe after removing one level, 2 unsafe"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("report lacks %q:\n%s", want, b.String())
+		}
+	}
+}
+
+// TestDay02ReportRemovals checks that the report counts reports saved by up to
+// part2-removals levels, as part two does.
+func TestDay02ReportRemovals(t *testing.T) {
+	tests := []struct {
+		removals int
+		want     []string
+	}{
+		{0, []string{"6 reports: 2 safe, 4 unsafe"}},
+		{2, []string{"remove 2 levels", "remove level 2 (3) or 3 (2)", "6 reports: 2 safe, 4 safe after removing up to 2 levels, 0 unsafe"}},
+	}
+
+	for _, tt := range tests {
+		opts := solver.Options{Params: solver.Params{"part2-removals": tt.removals}}
+		s, err := solver.New(2, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := solver.OpenInput(filepath.Join("..", solver.InputPath(2, true)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		var b strings.Builder
+		if err := s.(solver.Reporter).Report(f, &b); err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(b.String(), want) {
+				t.Errorf("part2-removals=%d: report lacks %q:\n%s", tt.removals, want, b.String())
+			}
+		}
+	}
+}
+
+func TestDay02Removals(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 25))
+	for range 2000 {
+		rules := day02.Rules{
+			MinStep:   rng.IntN(2),
+			MaxStep:   1 + rng.IntN(3),
+			Monotonic: rng.IntN(2) == 0,
+			Direction: day02.Direction(rng.IntN(3) - 1),
+		}
+		levels := make([]int, rng.IntN(10))
+		for i := range levels {
+			levels[i] = rng.IntN(8)
+		}
+		k := rng.IntN(4)
+
+		want := bruteRemovals(levels, rules)
+		if want > k {
+			want = -1
+		}
+		if got := rules.Removals(levels, k); got != want {
+			t.Fatalf("%+v.Removals(%v, %d) = %d, want %d", rules, levels, k, got, want)
+		}
+		if got, want := rules.Safe(levels), bruteRemovals(levels, rules) == 0; got != want {
+			t.Fatalf("%+v.Safe(%v) = %v, want %v", rules, levels, got, want)
+		}
+	}
+}
+
+// bruteRemovals tries every subset of the levels to keep.
+func bruteRemovals(levels []int, rules day02.Rules) int {
+	fewest := len(levels)
+	for keep := 0; keep < 1<<len(levels); keep++ {
+		var kept []int
+		for i, l := range levels {
+			if keep&(1<<i) != 0 {
+				kept = append(kept, l)
+			}
+		}
+		up, down, ok := false, false, true
+		for i := 1; i < len(kept); i++ {
+			diff := kept[i] - kept[i-1]
+			ok = ok && max(diff, -diff) >= rules.MinStep && max(diff, -diff) <= rules.MaxStep
+			up = up || diff > 0
+			down = down || diff < 0
+		}
+		ok = ok && !(rules.Monotonic && up && down) &&
+			!(rules.Direction == day02.Increasing && down) && !(rules.Direction == day02.Decreasing && up)
+		if ok {
+			fewest = min(fewest, len(levels)-len(kept))
+		}
+	}
+	return fewest
+}
+
+func TestDay02Rules(t *testing.T) {
+	tests := []struct {
+		params  solver.Params
+		example bool
+		one     int
+		two     int
+	}{
+		{nil, true, 2, 4},
+		{solver.Params{"part2-removals": 2}, true, 2, 6},
+		{solver.Params{"max-step": 4}, true, 3, 5},
+		{solver.Params{"direction": -1}, true, 1, 2},
+		{solver.Params{"min-step": 0, "monotonic": 0, "max-step": 5}, true, 6, 6},
+	}
+
+	for _, tt := range tests {
+		for _, reference := range []bool{false, true} {
+			s, err := solver.New(2, solver.Options{Params: tt.params, Reference: reference})
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := solver.OpenInput(filepath.Join("..", solver.InputPath(2, tt.example)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.Parse(f)
+			f.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if one, two := s.PartOne(context.Background()), s.PartTwo(context.Background()); one != tt.one || two != tt.two {
+				t.Errorf("params %v (reference %v): got %v, %v, want %d, %d", tt.params, reference, one, two, tt.one, tt.two)
+			}
+		}
+	}
+
+	s, err := solver.New(2, solver.Options{Params: solver.Params{"min-step": 3, "max-step": 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Parse(strings.NewReader("1 2 3\n")); err == nil {
+		t.Error("Parse accepted a maximum step below the minimum")
+	}
+}
@@ -0,0 +1,30 @@
+// Package days links every day's solver into the solver registry.
+package days
+
+import (
+	_ "github.com/reecepm/aoc-2024/day-01"
+	_ "github.com/reecepm/aoc-2024/day-02"
+	_ "github.com/reecepm/aoc-2024/day-03"
+	_ "github.com/reecepm/aoc-2024/day-04"
+	_ "github.com/reecepm/aoc-2024/day-05"
+	_ "github.com/reecepm/aoc-2024/day-06"
+	_ "github.com/reecepm/aoc-2024/day-07"
+	_ "github.com/reecepm/aoc-2024/day-08"
+	_ "github.com/reecepm/aoc-2024/day-09"
+	_ "github.com/reecepm/aoc-2024/day-10"
+	_ "github.com/reecepm/aoc-2024/day-11"
+	_ "github.com/reecepm/aoc-2024/day-12"
+	_ "github.com/reecepm/aoc-2024/day-13"
+	_ "github.com/reecepm/aoc-2024/day-14"
+	_ "github.com/reecepm/aoc-2024/day-15"
+	_ "github.com/reecepm/aoc-2024/day-16"
+	_ "github.com/reecepm/aoc-2024/day-17"
+	_ "github.com/reecepm/aoc-2024/day-18"
+	_ "github.com/reecepm/aoc-2024/day-19"
+	_ "github.com/reecepm/aoc-2024/day-20"
+	_ "github.com/reecepm/aoc-2024/day-21"
+	_ "github.com/reecepm/aoc-2024/day-22"
+	_ "github.com/reecepm/aoc-2024/day-23"
+	_ "github.com/reecepm/aoc-2024/day-24"
+	_ "github.com/reecepm/aoc-2024/day-25"
+)
@@ -0,0 +1,34 @@
+package days
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/reecepm/aoc-2024/bench"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/vault"
+)
+
+// BenchmarkDays measures parsing and both parts of every day on its real
+// input. Narrow it down with -bench, e.g. -bench 'Days/day14/'. Days whose
+// input is missing, or sealed without the key, are skipped.
+func BenchmarkDays(b *testing.B) {
+	for _, day := range solver.Days() {
+		cases, err := bench.Cases("..", []int{day}, false)
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, vault.ErrNoKey) {
+			b.Run(fmt.Sprintf("day%02d", day), func(b *testing.B) {
+				b.Skipf("skipping day %d: %v", day, err)
+			})
+			continue
+		}
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for _, c := range cases {
+			b.Run(c.Name(), c.Run)
+		}
+	}
+}
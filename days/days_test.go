@@ -0,0 +1,418 @@
+package days
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+	"github.com/reecepm/aoc-2024/vault"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata/answers.json with the current answers")
+
+const goldenFile = "testdata/answers.json"
+
+// goldenAnswers maps a two-digit day to the expected answers for each of that
+// day's input files. A missing part, such as day 25's second, is "".
+type goldenAnswers map[string]map[string][2]string
+
+// TestAnswers solves every input file of every registered day and compares
+// the answers with the golden file. Run with -update after a deliberate change
+// in answers, and -short to skip the real inputs, which take several seconds.
+func TestAnswers(t *testing.T) {
+	golden := make(goldenAnswers)
+	data, err := os.ReadFile(goldenFile)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &golden); err != nil {
+			t.Fatalf("decoding %s: %v", goldenFile, err)
+		}
+	case !*update || !os.IsNotExist(err):
+		t.Fatalf("reading golden answers: %v", err)
+	}
+
+	for _, day := range solver.Days() {
+		key := fmt.Sprintf("%02d", day)
+		if golden[key] == nil {
+			golden[key] = make(map[string][2]string)
+		}
+
+		for _, path := range inputFiles(t, day) {
+			name := filepath.Base(path)
+			t.Run(key+"/"+name, func(t *testing.T) {
+				if testing.Short() && name == solver.InputFile {
+					t.Skip("skipping real input in short mode")
+				}
+
+				answers := solve(t, day, path)
+				if *update {
+					golden[key][name] = answers
+					return
+				}
+
+				want, ok := golden[key][name]
+				if !ok {
+					t.Fatalf("no golden answers for day %s %s; run with -update", key, name)
+				}
+				for part := range answers {
+					if answers[part] != want[part] {
+						t.Errorf("part %d = %q, want %q", part+1, answers[part], want[part])
+					}
+				}
+			})
+		}
+	}
+
+	if *update {
+		writeGolden(t, golden)
+	}
+}
+
+// TestMalformedInput checks that strict parsing points at the offending line
+// and column, and that days with a lenient mode skip the same mistake.
+func TestMalformedInput(t *testing.T) {
+	tests := []struct {
+		day       int
+		input     string
+		line, col int
+		lenient   bool
+	}{
+		{1, "3   4\n4   x\n", 2, 5, true},
+		{1, "3   4\n4\n", 2, 0, true},
+		{2, "7 6 4\n1 2 ? 4\n", 2, 5, true},
+		{5, "47|53\n97|x\n\n75,47\n", 2, 4, false},
+		{5, "47|53\n", 1, 0, false},
+		{6, "..#\n.^x\n", 2, 3, true},
+		{6, "..#\n...\n", 0, 0, true},
+		{7, "190: 10 19\n3267 81\n", 2, 0, false},
+		{7, "190: 10 1x\n", 1, 9, false},
+		{9, "2333x\n", 1, 5, false},
+		{10, "0123\n12a4\n", 2, 3, true},
+		{11, "125 17 x\n", 1, 8, false},
+		{12, "", 0, 0, true},
+		{13, "Button A: X+94, Y+34\nPrize: X=8400, Y=5400\n", 2, 0, true},
+		{13, "Button A: X+94, Y+34\nButton B: X+22, Y+67\nPrize: X=8400, Y=5400\nhello\n", 4, 0, true},
+		{14, "p=0,4 v=3,-3\np=0,4 v=3\n", 2, 0, true},
+		{14, "p=500,4 v=3,-3\n", 1, 3, true},
+		{15, "#####\n#@.O#\n#####\n\n<>^x\n", 5, 4, true},
+		{15, "#####\n#@.O#\n####\n\n<>\n", 3, 5, false},
+		{16, "#####\n#S.E#\n#.x.#\n", 3, 3, true},
+		{17, "Register A: 729\nRegister B: 0\nRegister C: x\n\nProgram: 0,1\n", 3, 13, false},
+		{17, "Register A: 729\nRegister B: 0\nRegister C: 0\n\nProgram: 0,1,5,x\n", 5, 16, false},
+		{18, "5,4\n4,2\n4\n", 3, 0, true},
+		{18, "5,4\n4,y\n", 2, 3, true},
+		{19, "r, wr, b\n\nbrwrr\nbxr\n", 4, 2, true},
+		{20, "#####\n#S.E#\n#####\n#S..#\n", 0, 0, true},
+		{21, "029A\n980\n", 2, 3, false},
+		{22, "1\n10\n1x0\n", 3, 1, false},
+		{23, "kh-tc\nqp\n", 2, 0, true},
+		{24, "x00: 1\nx01: 2\n\nx00 AND x01 -> z00\n", 2, 6, true},
+		{24, "x00: 1\nx01: 0\n\nx00 NAND x01 -> z00\n", 4, 5, true},
+		{25, "#####\n.####\n.###\n", 3, 5, true},
+		{25, "..#..\n.....\n", 1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%02d/line%d", tt.day, tt.line), func(t *testing.T) {
+			s, err := solver.New(tt.day, solver.Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = s.Parse(strings.NewReader(tt.input))
+			var perr *parse.Error
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse error = %v, want a *parse.Error", err)
+			}
+			if perr.Line != tt.line || perr.Column != tt.col {
+				t.Errorf("error at line %d, column %d, want line %d, column %d: %v", perr.Line, perr.Column, tt.line, tt.col, err)
+			}
+
+			if !tt.lenient {
+				return
+			}
+			s, err = solver.New(tt.day, solver.Options{Mode: parse.Lenient})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := s.Parse(strings.NewReader(tt.input)); err != nil {
+				t.Errorf("lenient Parse: %v", err)
+			}
+		})
+	}
+}
+
+// TestParamRange checks that parameters out of range are rejected before
+// parsing rather than truncated or run with.
+func TestParamRange(t *testing.T) {
+	tests := []struct {
+		day   int
+		param string
+		value int
+	}{
+		{1, "stream-run", -1},
+		{2, "part2-removals", -1},
+		{2, "direction", 2},
+		{11, "part1-blinks", -1},
+		{11, "part2-blinks", 91},
+		{14, "width", 0},
+		{14, "height", math.MaxInt32 + 1},
+		{14, "seconds", -1},
+		{18, "bytes", -1},
+		{20, "part2-cheat", -1},
+		{21, "part1-robots", -1},
+		{21, "part2-robots", 36},
+		{22, "iterations", -1},
+	}
+
+	for _, tt := range tests {
+		_, err := solver.New(tt.day, solver.Options{Params: solver.Params{tt.param: tt.value}})
+		if err == nil || !strings.Contains(err.Error(), tt.param) {
+			t.Errorf("day %02d %s=%d: New error = %v, want one naming the parameter", tt.day, tt.param, tt.value, err)
+		}
+	}
+}
+
+// TestLongSimulation checks that day 14 gives the same safety factor a whole
+// number of cycles later, however many seconds that is.
+func TestLongSimulation(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("..", solver.InputPath(14, true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The example room is 11 by 7 tiles, so robots return every 77 seconds.
+	answers := make(map[string]bool)
+	for _, seconds := range []int{100, 100 + 77, 100 + 77*(math.MaxInt/77-2)} {
+		opts := solver.Options{Example: true, Params: solver.Params{"seconds": seconds, "search-limit": 0}}
+		res, err := solver.Run(context.Background(), 14, bytes.NewReader(input), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		answers[format(res.PartOne)] = true
+	}
+	if len(answers) != 1 {
+		t.Errorf("safety factors %v, want one", slices.Sorted(maps.Keys(answers)))
+	}
+}
+
+// TestTimeout checks that a part still running at the deadline is reported
+// as timed out rather than answered.
+func TestTimeout(t *testing.T) {
+	f, err := solver.OpenInput(filepath.Join("..", solver.InputPath(6, true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+
+	res, err := solver.Run(ctx, 6, f, solver.Options{Example: true})
+	var perr *solver.PartError
+	if !errors.As(err, &perr) || !errors.Is(err, solver.ErrTimedOut) {
+		t.Fatalf("err = %v, want a timed out *solver.PartError", err)
+	}
+	if perr.Part != 1 || res.PartOne != nil {
+		t.Errorf("part %d timed out with answer %v, want part 1 and no answer", perr.Part, res.PartOne)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	// The last frame of part one should carry its answer. Day 14 simulates
+	// 100 seconds and then adds the Christmas tree found by part two.
+	for _, tt := range []struct {
+		day   int
+		key   string
+		frame int
+	}{
+		{6, "visited", -1},
+		{14, "safety", 100},
+		{15, "gps", -1},
+		{16, "score", -1},
+		{17, "out", -1},
+	} {
+		day := tt.day
+		t.Run(fmt.Sprintf("day%02d", day), func(t *testing.T) {
+			f, err := solver.OpenInput(filepath.Join("..", solver.InputPath(day, true)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			var buf bytes.Buffer
+			tracer := trace.NewWriter(&buf)
+			res, err := solver.Run(context.Background(), day, f, solver.Options{Example: true, Tracer: tracer})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tracer.Err(); err != nil {
+				t.Fatal(err)
+			}
+
+			tr, err := trace.Read(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tr.Start.Day != day || len(tr.Steps) != tracer.Steps() {
+				t.Fatalf("read day %d with %d steps, want day %d with %d", tr.Start.Day, len(tr.Steps), day, tracer.Steps())
+			}
+
+			p := trace.NewPlayer(tr)
+			frame := tt.frame
+			if frame < 0 {
+				frame = p.Len()
+			}
+			p.Seek(frame)
+			if got, want := fmt.Sprint(p.State()[tt.key]), format(res.PartOne); got != want {
+				t.Errorf("%s at frame %d = %s, want part one's %s", tt.key, frame, got, want)
+			}
+
+			if day == 14 {
+				p.Seek(p.Len())
+				if got, want := fmt.Sprint(p.State()["second"]), format(res.PartTwo); got != want {
+					t.Errorf("last frame is second %s, want the tree at %s", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTraceUnsupported(t *testing.T) {
+	if _, err := solver.New(1, solver.Options{Tracer: trace.NewWriter(io.Discard)}); err == nil {
+		t.Error("tracing day 1 succeeded, want an error")
+	}
+}
+
+// TestTimeoutStopsPart checks that a part that runs past the deadline stops
+// soon after it, rather than running on in the background once Run has
+// reported the timeout.
+func TestTimeoutStopsPart(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping slow parts in short mode")
+	}
+	// Each takes seconds to solve, and a few milliseconds to parse.
+	maze, err := gen.New(16, 1, 700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buyers, err := gen.New(22, 1, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		day   int
+		input []byte
+		opts  solver.Options
+	}{
+		{16, maze.Data, maze.Options()},
+		{22, buyers.Data, solver.Options{Params: solver.Params{"iterations": 1 << 24}}},
+	}
+
+	for _, tt := range tests {
+		const timeout = 200 * time.Millisecond
+		before := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		res, err := solver.Run(ctx, tt.day, bytes.NewReader(tt.input), tt.opts)
+		var perr *solver.PartError
+		if !errors.As(err, &perr) || !errors.Is(err, solver.ErrTimedOut) || perr.Part != 1 {
+			t.Fatalf("day %d: err = %v, want part 1 timed out", tt.day, err)
+		}
+		if res.ParseTime > timeout/2 {
+			t.Logf("day %d: parsing took %v of the %v deadline", tt.day, res.ParseTime, timeout)
+			continue
+		}
+
+		for wait := time.Now(); runtime.NumGoroutine() > before; time.Sleep(10 * time.Millisecond) {
+			if time.Since(wait) > time.Second {
+				t.Fatalf("day %d: %d goroutines still running a second after the timeout, want %d", tt.day, runtime.NumGoroutine(), before)
+			}
+		}
+	}
+}
+
+// inputFiles lists a day's inputs, naming a sealed input by the plain file it
+// decrypts to. The real input is always listed, so that a missing one shows up
+// as a skipped case rather than no case at all.
+func inputFiles(t *testing.T, day int) []string {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join("..", solver.InputDir(day), "input*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := filepath.Glob(filepath.Join("..", solver.InputDir(day), "input*.txt"+vault.Ext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range append(sealed, filepath.Join("..", solver.InputPath(day, false))) {
+		path = strings.TrimSuffix(path, vault.Ext)
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+func solve(t *testing.T, day int, path string) [2]string {
+	t.Helper()
+
+	f, err := solver.OpenInput(path)
+	if errors.Is(err, vault.ErrNoKey) {
+		t.Skipf("skipping sealed input: %v", err)
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("skipping missing input: %v", err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	res, err := solver.Run(context.Background(), day, f, solver.Options{Example: solver.IsExamplePath(path)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return [2]string{format(res.PartOne), format(res.PartTwo)}
+}
+
+func format(answer any) string {
+	if answer == nil {
+		return ""
+	}
+	return fmt.Sprint(answer)
+}
+
+func writeGolden(t *testing.T, answers goldenAnswers) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(answers, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(goldenFile, append(data, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,90 @@
+package days
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+// TestGenerated checks that every day has a generator, that it is
+// deterministic, and that its inputs parse strictly and solve.
+func TestGenerated(t *testing.T) {
+	for _, day := range solver.Days() {
+		g, ok := gen.Lookup(day)
+		if !ok {
+			t.Errorf("day %d has no generator", day)
+			continue
+		}
+
+		for seed := uint64(1); seed <= 3; seed++ {
+			t.Run(fmt.Sprintf("%02d/seed%d", day, seed), func(t *testing.T) {
+				t.Parallel()
+				size := max(1, g.Default/8)
+				in, err := gen.New(day, seed, size)
+				if err != nil {
+					t.Fatal(err)
+				}
+				again, _ := gen.New(day, seed, size)
+				if !bytes.Equal(in.Data, again.Data) {
+					t.Fatal("the same seed and size gave different inputs")
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				if _, err := solver.Run(ctx, day, bytes.NewReader(in.Data), in.Options()); err != nil {
+					t.Fatalf("%v\ninput:\n%s", err, in.Data)
+				}
+			})
+		}
+	}
+}
+
+// TestGeneratedLongLine solves a day 09 disk map longer than the 64 KiB a
+// bufio.Scanner reads by default.
+func TestGeneratedLongLine(t *testing.T) {
+	in, err := gen.New(9, 1, 100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(in.Data) <= 64<<10 {
+		t.Fatalf("generated %d bytes, want a line over 64 KiB", len(in.Data))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, err := solver.Run(ctx, 9, bytes.NewReader(in.Data), in.Options()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// FuzzGenerated feeds generated inputs of every size to the solvers, looking
+// for inputs they reject or crash on:
+//
+//	go test -run '^$' -fuzz FuzzGenerated ./days
+func FuzzGenerated(f *testing.F) {
+	f.Add(6, uint64(1), 10)
+	f.Add(24, uint64(2), 5)
+	f.Fuzz(func(t *testing.T, day int, seed uint64, size int) {
+		if _, ok := gen.Lookup(day); !ok {
+			t.Skip()
+		}
+		// Small inputs keep each run quick and find the edge cases.
+		in, err := gen.New(day, seed, 1+(size&63))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = solver.Run(ctx, day, bytes.NewReader(in.Data), in.Options())
+		if err != nil && !errors.Is(err, solver.ErrTimedOut) {
+			t.Fatalf("%v\ninput:\n%s", err, in.Data)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package days
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+var seeds = flag.Uint64("seeds", 20, "generated inputs per day in TestReference")
+
+// TestReference compares every day's solver with its brute-force reference
+// on small generated inputs. Raise -seeds to search further.
+func TestReference(t *testing.T) {
+	for _, day := range solver.Days() {
+		if s, _ := solver.Lookup(day); s != nil {
+			if _, ok := s.(solver.Referenced); !ok {
+				t.Errorf("day %d has no reference solver", day)
+				continue
+			}
+		}
+
+		t.Run(fmt.Sprintf("%02d", day), func(t *testing.T) {
+			t.Parallel()
+			for seed := range *seeds {
+				in, err := gen.CheckInput(day, seed)
+				if err != nil {
+					t.Fatal(err)
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				mismatches, err := gen.Check(ctx, in)
+				cancel()
+				if err != nil {
+					t.Fatalf("seed %d: %v", seed, err)
+				}
+				for _, m := range mismatches {
+					t.Errorf("seed %d, size %d: %v\ninput:\n%s", seed, in.Size, m, in.Data)
+				}
+				if len(mismatches) > 0 {
+					return
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package gen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+// Mismatch is a part on which a day's solver and its reference disagree.
+type Mismatch struct {
+	Part      int
+	Got, Want string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("part %d = %s, reference says %s", m.Part, m.Got, m.Want)
+}
+
+// CheckInput generates the input Check uses for day and seed. Its size
+// cycles from 1 to the generator's CheckSize as the seed grows, so that a run
+// of seeds covers the smallest inputs too.
+func CheckInput(day int, seed uint64) (*Input, error) {
+	g, ok := Lookup(day)
+	if !ok {
+		return nil, fmt.Errorf("day %d has no generator", day)
+	}
+	size := 1 + int(seed%uint64(max(g.CheckSize, 1)))
+	in, err := New(day, seed, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(g.CheckParams) > 0 {
+		in.Params = maps.Clone(in.Params)
+		if in.Params == nil {
+			in.Params = make(solver.Params)
+		}
+		maps.Copy(in.Params, g.CheckParams)
+	}
+	return in, nil
+}
+
+// Check solves in with both its day's solver and the reference solver and
+// returns the parts on which they disagree.
+func Check(ctx context.Context, in *Input) ([]Mismatch, error) {
+	got, err := solve(ctx, in, false)
+	if err != nil {
+		return nil, err
+	}
+	want, err := solve(ctx, in, true)
+	if err != nil {
+		return nil, fmt.Errorf("reference: %w", err)
+	}
+
+	var mismatches []Mismatch
+	for part := range got {
+		if got[part] != want[part] {
+			mismatches = append(mismatches, Mismatch{part + 1, got[part], want[part]})
+		}
+	}
+	return mismatches, nil
+}
+
+func solve(ctx context.Context, in *Input, reference bool) ([2]string, error) {
+	opts := in.Options()
+	opts.Reference = reference
+	res, err := solver.Run(ctx, in.Day, bytes.NewReader(in.Data), opts)
+	if err != nil {
+		return [2]string{}, err
+	}
+	return [2]string{fmt.Sprint(res.PartOne), fmt.Sprint(res.PartTwo)}, nil
+}
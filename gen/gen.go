@@ -0,0 +1,86 @@
+// Package gen produces random but valid puzzle inputs of any size, for fuzzing
+// the solvers and benchmarking how they scale. Each day registers its
+// generator from its own package, next to the solver.
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+// Generator writes inputs for one day.
+type Generator struct {
+	// Size describes what the size controls, such as "side of the map".
+	Size string
+	// Default is a size close to the real input's.
+	Default int
+	// Generate returns an input of the given size, which it may raise to the
+	// smallest size that makes sense. Inputs that need parameters other than
+	// the defaults, such as a larger memory space, return them too.
+	Generate func(rng *rand.Rand, size int) (string, solver.Params)
+	// CheckSize is the largest size Check uses, small enough for the day's
+	// reference solver to finish quickly.
+	CheckSize int
+	// CheckParams override parameters in Check, such as day 11's blink
+	// counts, where the real ones are out of the reference solver's reach.
+	CheckParams solver.Params
+}
+
+var registry = make(map[int]Generator)
+
+// Register makes a day's generator available. It is intended to be called
+// from the init function of each day's package.
+func Register(day int, g Generator) {
+	if _, exists := registry[day]; exists {
+		panic(fmt.Sprintf("gen: day %d registered twice", day))
+	}
+	registry[day] = g
+}
+
+// Lookup returns the generator for the given day.
+func Lookup(day int) (Generator, bool) {
+	g, ok := registry[day]
+	return g, ok
+}
+
+// Days returns every day with a generator in ascending order.
+func Days() []int {
+	days := make([]int, 0, len(registry))
+	for day := range registry {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
+
+// Input is a generated input and the parameters it should be solved with.
+type Input struct {
+	Day    int
+	Seed   uint64
+	Size   int
+	Data   []byte
+	Params solver.Params
+}
+
+// Options returns the solver options for solving the input.
+func (in *Input) Options() solver.Options {
+	return solver.Options{Params: in.Params}
+}
+
+// New generates an input for day. The same day, seed and size always give
+// the same input. A size of zero or less uses the generator's default.
+func New(day int, seed uint64, size int) (*Input, error) {
+	g, ok := Lookup(day)
+	if !ok {
+		return nil, fmt.Errorf("day %d has no generator", day)
+	}
+	if size <= 0 {
+		size = g.Default
+	}
+	rng := rand.New(rand.NewPCG(seed, uint64(day)))
+	data, params := g.Generate(rng, size)
+	return &Input{Day: day, Seed: seed, Size: size, Data: []byte(data), Params: params}, nil
+}
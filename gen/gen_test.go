@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+func TestNew(t *testing.T) {
+	Register(99, Generator{Size: "letters", Default: 4, Generate: func(rng *rand.Rand, size int) (string, solver.Params) {
+		return strings.Repeat(string(rune('a'+rng.IntN(26))), size), solver.Params{"size": size}
+	}})
+	t.Cleanup(func() { delete(registry, 99) })
+
+	in, err := New(99, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in.Size != 4 || len(in.Data) != 4 || in.Options().Params["size"] != 4 {
+		t.Errorf("default size gave %+v, want 4 letters", in)
+	}
+
+	seen := make(map[string]bool)
+	for seed := range uint64(20) {
+		a, _ := New(99, seed, 3)
+		b, _ := New(99, seed, 3)
+		if string(a.Data) != string(b.Data) {
+			t.Fatalf("seed %d gave %q then %q", seed, a.Data, b.Data)
+		}
+		seen[string(a.Data)] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("20 seeds all gave %v", seen)
+	}
+
+	if _, err := New(98, 1, 1); err == nil {
+		t.Error("New(98) succeeded without a generator")
+	}
+}
+
+func TestMaze(t *testing.T) {
+	g, path := Maze(rand.New(rand.NewPCG(1, 2)), 11)
+	if len(g) != 11 || len(g[0]) != 11 {
+		t.Fatalf("maze is %dx%d, want 11x11", len(g[0]), len(g))
+	}
+	if first, last := path[0], path[len(path)-1]; first.X != 1 || first.Y != 9 || last.X != 9 || last.Y != 1 {
+		t.Errorf("path runs from %v to %v, want the bottom left to the top right", first, last)
+	}
+	for i, p := range path {
+		if g.At(p) != '.' {
+			t.Errorf("path crosses wall at %v", p)
+		}
+		if i > 0 && p.Manhattan(path[i-1]) != 1 {
+			t.Errorf("path jumps from %v to %v", path[i-1], p)
+		}
+	}
+	for _, row := range g {
+		if row[0] != '#' || row[10] != '#' {
+			t.Errorf("row %q has no side walls", row)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/grid"
+)
+
+// Grid is a character grid being drawn by a generator.
+type Grid [][]byte
+
+// NewGrid returns a width by height grid filled with fill.
+func NewGrid(width, height int, fill byte) Grid {
+	g := make(Grid, height)
+	for y := range g {
+		g[y] = []byte(strings.Repeat(string(fill), width))
+	}
+	return g
+}
+
+func (g Grid) Set(p grid.Point, ch byte) {
+	g[p.Y][p.X] = ch
+}
+
+func (g Grid) At(p grid.Point) byte {
+	return g[p.Y][p.X]
+}
+
+func (g Grid) Contains(p grid.Point) bool {
+	return p.Y >= 0 && p.Y < len(g) && p.X >= 0 && p.X < len(g[p.Y])
+}
+
+// String returns the rows of the grid, each ending in a newline.
+func (g Grid) String() string {
+	var b strings.Builder
+	for _, row := range g {
+		b.Write(row)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Maze carves a perfect maze into a side by side grid of walls, with
+// passages on the odd coordinates, and returns it with the passage from the
+// bottom left corner to the top right one. Side must be odd and at least 5.
+func Maze(rng *rand.Rand, side int) (Grid, []grid.Point) {
+	g := NewGrid(side, side, '#')
+	start := grid.Point{X: 1, Y: side - 2}
+	end := grid.Point{X: side - 2, Y: 1}
+
+	parent := map[grid.Point]grid.Point{start: start}
+	g.Set(start, '.')
+	stack := []grid.Point{start}
+	dirs := append([]grid.Point(nil), grid.Cardinals...)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		rng.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
+		carved := false
+		for _, d := range dirs {
+			next := cur.Add(d.Mul(2))
+			if next.X < 1 || next.Y < 1 || next.X > side-2 || next.Y > side-2 || g.At(next) == '.' {
+				continue
+			}
+			g.Set(cur.Add(d), '.')
+			g.Set(next, '.')
+			parent[next] = cur
+			stack = append(stack, next)
+			carved = true
+			break
+		}
+		if !carved {
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	path := []grid.Point{end}
+	for p := end; p != start; {
+		prev := parent[p]
+		path = append(path, grid.Point{X: (p.X + prev.X) / 2, Y: (p.Y + prev.Y) / 2}, prev)
+		p = prev
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return g, path
+}
@@ -1,9 +1,10 @@
-module github.com/reecepm/aoc-2024/day-14
+module github.com/reecepm/aoc-2024
 
 go 1.23.2
 
+require github.com/fatih/color v1.18.0
+
 require (
-	github.com/fatih/color v1.18.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	golang.org/x/sys v0.25.0 // indirect
@@ -0,0 +1,92 @@
+package grid
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Bounds is a rectangle anchored at the origin.
+type Bounds struct {
+	Width, Height int
+}
+
+func (b Bounds) Contains(p Point) bool {
+	return p.X >= 0 && p.X < b.Width && p.Y >= 0 && p.Y < b.Height
+}
+
+// Grid is a dense, fixed-size grid of cells stored row by row.
+type Grid[T any] struct {
+	Bounds
+	cells []T
+}
+
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{
+		Bounds: Bounds{width, height},
+		cells:  make([]T, width*height),
+	}
+}
+
+// At returns the cell at p. It panics if p is out of bounds.
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[g.checked(p)]
+}
+
+// Get returns the cell at p and whether p lies within the grid.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.Contains(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[g.index(p)], true
+}
+
+// Set stores v at p. It panics if p is out of bounds.
+func (g *Grid[T]) Set(p Point, v T) {
+	g.cells[g.checked(p)] = v
+}
+
+func (g *Grid[T]) Clone() *Grid[T] {
+	cells := make([]T, len(g.cells))
+	copy(cells, g.cells)
+	return &Grid[T]{Bounds: g.Bounds, cells: cells}
+}
+
+// Row returns the cells of row y. The slice aliases the grid's storage.
+func (g *Grid[T]) Row(y int) []T {
+	return g.cells[y*g.Width : (y+1)*g.Width]
+}
+
+// All iterates over every cell in row-major order.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{i % g.Width, i / g.Width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first point in row-major order whose cell satisfies match.
+func (g *Grid[T]) Find(match func(T) bool) (Point, bool) {
+	for p, v := range g.All() {
+		if match(v) {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+func (g *Grid[T]) index(p Point) int {
+	return p.Y*g.Width + p.X
+}
+
+// checked is index for a point that must lie within the grid. Without the
+// check, an X past either edge would reach into the neighbouring row.
+func (g *Grid[T]) checked(p Point) int {
+	if !g.Contains(p) {
+		panic(fmt.Sprintf("grid: point %v outside a %dx%d grid", p, g.Width, g.Height))
+	}
+	return g.index(p)
+}
@@ -0,0 +1,129 @@
+package grid
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/reecepm/aoc-2024/parse"
+)
+
+func TestFromLines(t *testing.T) {
+	g, err := FromLines([]string{"ab", "cd"}, Runes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Width != 2 || g.Height != 2 || g.At(Point{1, 1}) != 'd' || string(g.Row(1)) != "cd" {
+		t.Errorf("FromLines = %dx%d %q", g.Width, g.Height, string(g.cells))
+	}
+
+	errBad := errors.New("bad cell")
+	tests := []struct {
+		lines        []string
+		cell         func(Point, rune) (rune, error)
+		line, column int
+	}{
+		{[]string{"abc", "ab"}, Runes, 2, 3},
+		{[]string{"ab", "abcd"}, Runes, 2, 3},
+		{[]string{"ab", "a#"}, func(_ Point, ch rune) (rune, error) {
+			if ch == '#' {
+				return 0, errBad
+			}
+			return ch, nil
+		}, 2, 2},
+	}
+	for _, tt := range tests {
+		_, err := FromLines(tt.lines, tt.cell)
+		var pe *parse.Error
+		if !errors.As(err, &pe) || pe.Line != tt.line || pe.Column != tt.column {
+			t.Errorf("FromLines(%q) error = %v, want line %d column %d", tt.lines, err, tt.line, tt.column)
+		}
+	}
+
+	g, err = FromLines(nil, Runes)
+	if err != nil || g.Width != 0 || g.Height != 0 {
+		t.Errorf("FromLines(nil) = %v, %v", g, err)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	for i, d := range Cardinals {
+		if got, want := d.RotateRight(), Cardinals[(i+1)%4]; got != want {
+			t.Errorf("%v.RotateRight() = %v, want %v", d, got, want)
+		}
+		if got, want := d.RotateLeft(), Cardinals[(i+3)%4]; got != want {
+			t.Errorf("%v.RotateLeft() = %v, want %v", d, got, want)
+		}
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	p := Point{5, 5}
+	if got, want := p.Neighbours4(), []Point{{5, 4}, {6, 5}, {5, 6}, {4, 5}}; !slices.Equal(got, want) {
+		t.Errorf("Neighbours4 = %v, want %v", got, want)
+	}
+	want := []Point{{5, 4}, {6, 4}, {6, 5}, {6, 6}, {5, 6}, {4, 6}, {4, 5}, {4, 4}}
+	if got := p.Neighbours8(); !slices.Equal(got, want) {
+		t.Errorf("Neighbours8 = %v, want %v", got, want)
+	}
+}
+
+func TestGet(t *testing.T) {
+	g := New[int](3, 2)
+	g.Set(Point{2, 1}, 7)
+	if v, ok := g.Get(Point{2, 1}); !ok || v != 7 {
+		t.Errorf("Get(2,1) = %d, %v", v, ok)
+	}
+	for _, p := range []Point{{-1, 0}, {3, 0}, {0, -1}, {0, 2}} {
+		if v, ok := g.Get(p); ok || v != 0 {
+			t.Errorf("Get(%v) = %d, %v, want out of bounds", p, v, ok)
+		}
+	}
+}
+
+func TestAtOutOfBounds(t *testing.T) {
+	g := New[int](3, 2)
+	for _, p := range []Point{{3, 0}, {-1, 1}, {0, 2}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("At(%v) did not panic", p)
+				}
+			}()
+			g.At(p)
+		}()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Set(%v) did not panic", p)
+				}
+			}()
+			g.Set(p, 1)
+		}()
+	}
+}
+
+func TestSparse(t *testing.T) {
+	s := NewSparse[rune]()
+	s.Set(Point{5, 5}, 'a')
+	s.Delete(Point{5, 5})
+	s.Set(Point{0, 0}, 'b')
+	s.Set(Point{-2, 3}, 'c')
+
+	if lo, hi := s.Extent(); lo != (Point{-2, 0}) || hi != (Point{5, 5}) {
+		t.Errorf("Extent = %v, %v, want (-2,0), (5,5)", lo, hi)
+	}
+	if s.Len() != 2 || s.Has(Point{5, 5}) || !s.Has(Point{-2, 3}) {
+		t.Errorf("Len = %d, cells %v", s.Len(), s.cells)
+	}
+	if v, ok := s.Get(Point{0, 0}); !ok || v != 'b' {
+		t.Errorf("Get(0,0) = %q, %v", v, ok)
+	}
+	n := 0
+	for range s.All() {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("All yielded %d cells, want 2", n)
+	}
+}
@@ -0,0 +1,59 @@
+package grid
+
+import (
+	"io"
+
+	"github.com/reecepm/aoc-2024/parse"
+)
+
+// Parse reads a rectangular grid from r, one row per line, stopping at the
+// first blank line or the end of the input.
+func Parse[T any](r io.Reader, cell func(Point, rune) (T, error)) (*Grid[T], error) {
+	var lines []string
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return FromLines(lines, cell)
+}
+
+// FromLines builds a grid from already split rows. Every row must be the same
+// width. Errors are *parse.Error values positioned as if the rows started on
+// the first line of the input.
+func FromLines[T any](lines []string, cell func(Point, rune) (T, error)) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return New[T](0, 0), nil
+	}
+
+	g := New[T](len([]rune(lines[0])), len(lines))
+	for y, line := range lines {
+		row := []rune(line)
+		if len(row) != g.Width {
+			return nil, parse.Errorf(y+1, min(len(row), g.Width)+1, "row has width %d, want %d", len(row), g.Width)
+		}
+
+		for x, ch := range row {
+			p := Point{x, y}
+			v, err := cell(p, ch)
+			if err != nil {
+				return nil, &parse.Error{Line: y + 1, Column: x + 1, Err: err}
+			}
+			g.Set(p, v)
+		}
+	}
+	return g, nil
+}
+
+// Runes is a cell function that keeps each character as is.
+func Runes(_ Point, ch rune) (rune, error) {
+	return ch, nil
+}
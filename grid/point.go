@@ -0,0 +1,80 @@
+package grid
+
+// Point is a position or offset on a grid. X grows to the right and Y grows
+// downwards, matching the order lines appear in the puzzle input.
+type Point struct {
+	X, Y int
+}
+
+var (
+	Up        = Point{0, -1}
+	Right     = Point{1, 0}
+	Down      = Point{0, 1}
+	Left      = Point{-1, 0}
+	UpRight   = Point{1, -1}
+	DownRight = Point{1, 1}
+	DownLeft  = Point{-1, 1}
+	UpLeft    = Point{-1, -1}
+)
+
+// Cardinals lists the four orthogonal directions clockwise from Up, so that
+// stepping one index forward is a right turn.
+var Cardinals = []Point{Up, Right, Down, Left}
+
+// Diagonals lists the four diagonal directions clockwise from UpRight.
+var Diagonals = []Point{UpRight, DownRight, DownLeft, UpLeft}
+
+// Compass lists all eight directions clockwise from Up.
+var Compass = []Point{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+
+func (p Point) Add(o Point) Point {
+	return Point{p.X + o.X, p.Y + o.Y}
+}
+
+func (p Point) Sub(o Point) Point {
+	return Point{p.X - o.X, p.Y - o.Y}
+}
+
+func (p Point) Mul(factor int) Point {
+	return Point{p.X * factor, p.Y * factor}
+}
+
+// RotateLeft turns a direction 90 degrees anticlockwise.
+func (p Point) RotateLeft() Point {
+	return Point{p.Y, -p.X}
+}
+
+// RotateRight turns a direction 90 degrees clockwise.
+func (p Point) RotateRight() Point {
+	return Point{-p.Y, p.X}
+}
+
+func (p Point) Manhattan(o Point) int {
+	return abs(p.X-o.X) + abs(p.Y-o.Y)
+}
+
+// Neighbours4 returns the orthogonally adjacent points in Cardinals order.
+func (p Point) Neighbours4() []Point {
+	return p.neighbours(Cardinals)
+}
+
+// Neighbours8 returns the orthogonally and diagonally adjacent points in
+// Compass order.
+func (p Point) Neighbours8() []Point {
+	return p.neighbours(Compass)
+}
+
+func (p Point) neighbours(dirs []Point) []Point {
+	out := make([]Point, len(dirs))
+	for i, d := range dirs {
+		out[i] = p.Add(d)
+	}
+	return out
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,64 @@
+package grid
+
+import "iter"
+
+// Sparse is an unbounded grid that only stores the cells that have been set.
+// It tracks the smallest rectangle containing every point ever set.
+type Sparse[T any] struct {
+	cells    map[Point]T
+	min, max Point
+	// extended is whether any point has been set, and so whether min and max
+	// mean anything.
+	extended bool
+}
+
+func NewSparse[T any]() *Sparse[T] {
+	return &Sparse[T]{cells: make(map[Point]T)}
+}
+
+func (s *Sparse[T]) Get(p Point) (T, bool) {
+	v, ok := s.cells[p]
+	return v, ok
+}
+
+func (s *Sparse[T]) Has(p Point) bool {
+	_, ok := s.cells[p]
+	return ok
+}
+
+func (s *Sparse[T]) Set(p Point, v T) {
+	if !s.extended {
+		s.min, s.max = p, p
+		s.extended = true
+	} else {
+		s.min = Point{min(s.min.X, p.X), min(s.min.Y, p.Y)}
+		s.max = Point{max(s.max.X, p.X), max(s.max.Y, p.Y)}
+	}
+	s.cells[p] = v
+}
+
+// Delete removes p. The extent is not shrunk.
+func (s *Sparse[T]) Delete(p Point) {
+	delete(s.cells, p)
+}
+
+func (s *Sparse[T]) Len() int {
+	return len(s.cells)
+}
+
+// Extent returns the top-left and bottom-right corners, inclusive, of every
+// point set so far.
+func (s *Sparse[T]) Extent() (Point, Point) {
+	return s.min, s.max
+}
+
+// All iterates over the stored cells in no particular order.
+func (s *Sparse[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for p, v := range s.cells {
+			if !yield(p, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Package logging sets up the log/slog loggers shared by the command and the
+// solvers. Answers, diagnostics and drawings of a solver's state are logged
+// at their own levels, so that a verbosity picks which of them are shown and
+// the JSON handler lets other programs tell them apart.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// The levels used besides slog's own. Trace is below debug and carries
+// drawings of a solver's state; Answer is above info, so that a quiet run
+// still prints its answers.
+const (
+	LevelTrace  = slog.Level(-8)
+	LevelAnswer = slog.Level(2)
+)
+
+// Verbosities maps each verbosity to the lowest level it shows.
+var Verbosities = map[string]slog.Level{
+	"quiet":  LevelAnswer,
+	"normal": slog.LevelInfo,
+	"debug":  slog.LevelDebug,
+	"trace":  LevelTrace,
+}
+
+// ParseVerbosity returns the lowest level shown at the named verbosity.
+func ParseVerbosity(name string) (slog.Level, error) {
+	level, ok := Verbosities[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown verbosity %q, want quiet, normal, debug or trace", name)
+	}
+	return level, nil
+}
+
+// LevelName returns the name of a level, including the ones of this package.
+func LevelName(level slog.Level) string {
+	switch level {
+	case LevelTrace:
+		return "TRACE"
+	case LevelAnswer:
+		return "ANSWER"
+	}
+	return level.String()
+}
+
+// New returns a logger writing to w the records at level and above, as JSON
+// lines or as the plain text of NewTextHandler.
+func New(w io.Writer, level slog.Leveler, json bool) *slog.Logger {
+	if !json {
+		return slog.New(NewTextHandler(w, level))
+	}
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.LevelKey && len(groups) == 0 {
+				a.Value = slog.StringValue(LevelName(a.Value.Any().(slog.Level)))
+			}
+			return a
+		},
+	}))
+}
+
+// Discard returns a logger that drops every record, for solvers run without
+// one.
+func Discard() *slog.Logger {
+	return slog.New(discardHandler{})
+}
+
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (d discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return d }
+func (d discardHandler) WithGroup(string) slog.Handler           { return d }
@@ -0,0 +1,62 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, slog.LevelDebug, false).With("day", 14)
+
+	logger.Log(context.Background(), LevelAnswer, "answer", "part", 1, "answer", 12)
+	logger.Debug("christmas tree found", "second", 7)
+	logger.Log(context.Background(), LevelTrace, "room", "drawing", "#.\n.#\n")
+	logger.WithGroup("memo").Info("stats", "name", "day11/stones", "note", "two words")
+
+	want := "answer day=14 part=1 answer=12\n" +
+		"DEBUG christmas tree found day=14 second=7\n" +
+		"stats day=14 memo.name=day11/stones memo.note=\"two words\"\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestTextDrawing(t *testing.T) {
+	var buf bytes.Buffer
+	New(&buf, LevelTrace, false).Log(context.Background(), LevelTrace, "room", "second", 3, "drawing", "#.\n.#")
+
+	want := "TRACE room second=3\n#.\n.#\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, LevelAnswer, true)
+	logger.Info("parsed")
+	logger.Log(context.Background(), LevelAnswer, "answer", "part", 2, "answer", 31)
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("%v in %q", err, buf.String())
+	}
+	if record["level"] != "ANSWER" || record["msg"] != "answer" || record["answer"] != 31.0 {
+		t.Errorf("record = %v", record)
+	}
+}
+
+func TestParseVerbosity(t *testing.T) {
+	for name, want := range Verbosities {
+		if got, err := ParseVerbosity(name); err != nil || got != want {
+			t.Errorf("ParseVerbosity(%q) = %v, %v; want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseVerbosity("loud"); err == nil {
+		t.Error("ParseVerbosity(\"loud\") succeeded")
+	}
+}
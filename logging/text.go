@@ -0,0 +1,106 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// TextHandler writes each record as its message followed by key=value
+// attributes, without the time. Levels other than info and answer are named
+// before the message. Values spanning several lines, such as drawings of a
+// grid, follow the record on lines of their own.
+type TextHandler struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	level  slog.Leveler
+	prefix string
+	attrs  []byte
+}
+
+// NewTextHandler returns a TextHandler writing the records at level and above
+// to w.
+func NewTextHandler(w io.Writer, level slog.Leveler) *TextHandler {
+	return &TextHandler{mu: new(sync.Mutex), w: w, level: level}
+}
+
+func (h *TextHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *TextHandler) Handle(_ context.Context, r slog.Record) error {
+	var buf, blocks bytes.Buffer
+	if r.Level != slog.LevelInfo && r.Level != LevelAnswer {
+		buf.WriteString(LevelName(r.Level))
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(r.Message)
+	buf.Write(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		appendAttr(&buf, &blocks, h.prefix, a)
+		return true
+	})
+	buf.WriteByte('\n')
+	buf.Write(blocks.Bytes())
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := h.w.Write(buf.Bytes())
+	return err
+}
+
+func (h *TextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	var buf, blocks bytes.Buffer
+	for _, a := range attrs {
+		appendAttr(&buf, &blocks, h.prefix, a)
+	}
+	h2.attrs = append(append([]byte(nil), h.attrs...), buf.Bytes()...)
+	return &h2
+}
+
+func (h *TextHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.prefix = h.prefix + name + "."
+	return &h2
+}
+
+// appendAttr writes a to buf, or to blocks when its value spans several lines.
+func appendAttr(buf, blocks *bytes.Buffer, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			appendAttr(buf, blocks, prefix, ga)
+		}
+		return
+	}
+
+	s := a.Value.String()
+	if strings.Contains(strings.TrimSuffix(s, "\n"), "\n") {
+		blocks.WriteString(s)
+		if !strings.HasSuffix(s, "\n") {
+			blocks.WriteByte('\n')
+		}
+		return
+	}
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		s = strconv.Quote(s)
+	}
+	buf.WriteByte(' ')
+	buf.WriteString(prefix + a.Key)
+	buf.WriteByte('=')
+	buf.WriteString(s)
+}
@@ -0,0 +1,208 @@
+package memo
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Options control a cache's behaviour.
+type Options struct {
+	// Name adds the cache's statistics to the process-wide Report under this
+	// name. Caches sharing a name are counted together.
+	Name string
+	// Concurrent makes the cache safe for use by several goroutines.
+	Concurrent bool
+	// MaxEntries bounds the cache's size. Once full, storing a new key evicts
+	// an arbitrary existing one. Zero means unbounded. A limit set with
+	// SetLimit for the cache's name takes precedence.
+	MaxEntries int
+}
+
+// Stats counts how a cache has been used.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRate returns the fraction of lookups that found a value.
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+func (s Stats) String() string {
+	return fmt.Sprintf("%d hits, %d misses (%.1f%% hit rate), %d evictions",
+		s.Hits, s.Misses, 100*s.HitRate(), s.Evictions)
+}
+
+type counters struct {
+	hits, misses, evictions atomic.Uint64
+}
+
+func (c *counters) stats() Stats {
+	return Stats{c.hits.Load(), c.misses.Load(), c.evictions.Load()}
+}
+
+// Cache memoises values by key for recursive solvers.
+type Cache[K comparable, V any] struct {
+	mu      *sync.Mutex
+	entries map[K]V
+	max     int
+	own     counters
+	shared  *counters
+}
+
+func New[K comparable, V any](opts Options) *Cache[K, V] {
+	c := &Cache[K, V]{
+		entries: make(map[K]V),
+		max:     opts.MaxEntries,
+	}
+	if opts.Concurrent {
+		c.mu = new(sync.Mutex)
+	}
+	if opts.Name != "" {
+		c.shared = named(opts.Name)
+		if max, ok := limit(opts.Name); ok {
+			c.max = max
+		}
+	}
+	return c
+}
+
+// Get returns the value stored for key and records a hit or a miss.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.lock()
+	v, ok := c.entries[key]
+	c.unlock()
+
+	if ok {
+		c.own.hits.Add(1)
+		if c.shared != nil {
+			c.shared.hits.Add(1)
+		}
+	} else {
+		c.own.misses.Add(1)
+		if c.shared != nil {
+			c.shared.misses.Add(1)
+		}
+	}
+	return v, ok
+}
+
+// Put stores a value for key, evicting another entry if the cache is full.
+func (c *Cache[K, V]) Put(key K, v V) {
+	c.lock()
+	defer c.unlock()
+
+	if _, exists := c.entries[key]; !exists && c.max > 0 && len(c.entries) >= c.max {
+		for evict := range c.entries {
+			delete(c.entries, evict)
+			break
+		}
+		c.own.evictions.Add(1)
+		if c.shared != nil {
+			c.shared.evictions.Add(1)
+		}
+	}
+	c.entries[key] = v
+}
+
+// Do returns the value stored for key, computing and storing it first when
+// there is none. The lock is not held while compute runs, so compute may use
+// the cache recursively.
+func (c *Cache[K, V]) Do(key K, compute func() V) V {
+	if v, ok := c.Get(key); ok {
+		return v
+	}
+	v := compute()
+	c.Put(key, v)
+	return v
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.lock()
+	defer c.unlock()
+	return len(c.entries)
+}
+
+// Stats returns this cache's own statistics.
+func (c *Cache[K, V]) Stats() Stats {
+	return c.own.stats()
+}
+
+func (c *Cache[K, V]) lock() {
+	if c.mu != nil {
+		c.mu.Lock()
+	}
+}
+
+func (c *Cache[K, V]) unlock() {
+	if c.mu != nil {
+		c.mu.Unlock()
+	}
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = make(map[string]*counters)
+	limits     = make(map[string]int)
+)
+
+// SetLimit overrides MaxEntries for caches created with the given name from
+// now on, so that cache sizes can be tuned without editing the solvers.
+func SetLimit(name string, max int) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	limits[name] = max
+}
+
+func limit(name string) (int, bool) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	max, ok := limits[name]
+	return max, ok
+}
+
+func named(name string) *counters {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	c, ok := registry[name]
+	if !ok {
+		c = new(counters)
+		registry[name] = c
+	}
+	return c
+}
+
+// Entry is one line of a Report.
+type Entry struct {
+	Name string
+	Stats
+}
+
+// Report returns the combined statistics of every named cache created so far,
+// ordered by name.
+func Report() []Entry {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	entries := make([]Entry, 0, len(registry))
+	for name, c := range registry {
+		entries = append(entries, Entry{name, c.stats()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries
+}
+
+// ResetReport clears the statistics gathered for Report.
+func ResetReport() {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	clear(registry)
+}
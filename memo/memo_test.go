@@ -0,0 +1,106 @@
+package memo
+
+import (
+	"sync"
+	"testing"
+)
+
+func fib(c *Cache[int, int], n int) int {
+	if n < 2 {
+		return n
+	}
+	return c.Do(n, func() int { return fib(c, n-1) + fib(c, n-2) })
+}
+
+func TestDoRecursive(t *testing.T) {
+	c := New[int, int](Options{})
+	if got := fib(c, 90); got != 2880067194370816120 {
+		t.Fatalf("fib(90) = %d", got)
+	}
+
+	// Every n from 2 to 90 misses once; every n from 2 to 88 is then found
+	// again by its second caller.
+	want := Stats{Hits: 87, Misses: 89}
+	if got := c.Stats(); got != want {
+		t.Errorf("Stats = %+v, want %+v", got, want)
+	}
+	if c.Len() != 89 {
+		t.Errorf("Len = %d, want 89", c.Len())
+	}
+}
+
+func TestMaxEntries(t *testing.T) {
+	c := New[int, string](Options{MaxEntries: 2})
+	c.Put(1, "a")
+	c.Put(2, "b")
+	c.Put(2, "B")
+	if c.Len() != 2 || c.Stats().Evictions != 0 {
+		t.Fatalf("overwriting evicted: len %d, %v", c.Len(), c.Stats())
+	}
+
+	c.Put(3, "c")
+	if c.Len() != 2 || c.Stats().Evictions != 1 {
+		t.Errorf("after a third key: len %d, %v", c.Len(), c.Stats())
+	}
+	if v, ok := c.Get(3); !ok || v != "c" {
+		t.Errorf("Get(3) = %q, %v", v, ok)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	c := New[int, int](Options{Concurrent: true, MaxEntries: 50})
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if v := c.Do(i%100, func() int { return i % 100 * 2 }); v != i%100*2 {
+					t.Errorf("Do(%d) = %d", i%100, v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	s := c.Stats()
+	if s.Hits+s.Misses != 8000 {
+		t.Errorf("recorded %d lookups, want 8000", s.Hits+s.Misses)
+	}
+	if c.Len() > 50 {
+		t.Errorf("Len = %d, want at most 50", c.Len())
+	}
+}
+
+func TestReport(t *testing.T) {
+	ResetReport()
+	t.Cleanup(ResetReport)
+
+	for range 2 {
+		c := New[string, int](Options{Name: "test/shared"})
+		c.Get("x")
+		c.Put("x", 1)
+		c.Get("x")
+	}
+
+	report := Report()
+	if len(report) != 1 || report[0].Name != "test/shared" {
+		t.Fatalf("Report = %+v", report)
+	}
+	if want := (Stats{Hits: 2, Misses: 2}); report[0].Stats != want {
+		t.Errorf("combined stats = %+v, want %+v", report[0].Stats, want)
+	}
+}
+
+func TestSetLimit(t *testing.T) {
+	SetLimit("test/limited", 1)
+	t.Cleanup(func() { SetLimit("test/limited", 0) })
+
+	c := New[int, int](Options{Name: "test/limited", MaxEntries: 10})
+	c.Put(1, 1)
+	c.Put(2, 2)
+	if c.Len() != 1 {
+		t.Errorf("Len = %d, want the SetLimit cap of 1", c.Len())
+	}
+}
@@ -0,0 +1,166 @@
+package parse
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Mode selects how a day's parser treats malformed input.
+type Mode int
+
+const (
+	// Strict rejects malformed input with an *Error. It is the default.
+	Strict Mode = iota
+	// Lenient skips malformed lines where the format allows it, which is how
+	// the parsers originally behaved.
+	Lenient
+)
+
+// ErrEmpty is reported when an input has no content at all.
+var ErrEmpty = errors.New("empty input")
+
+// Error points at the malformed part of an input. Line and Column are
+// 1-based; a zero Column blames the line as a whole and a zero Line the input
+// as a whole.
+type Error struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return e.Err.Error()
+	}
+	if e.Column == 0 {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func Errorf(line, column int, format string, args ...any) error {
+	return &Error{Line: line, Column: column, Err: fmt.Errorf(format, args...)}
+}
+
+// Scanner reads an input line by line and remembers which line it is on, so
+// that errors can point back at it.
+type Scanner struct {
+	*bufio.Scanner
+	line int
+}
+
+// MaxLineBytes is the longest line a Scanner reads, well beyond the single
+// line of day 09's disk map at any size worth generating.
+const MaxLineBytes = 64 << 20
+
+func NewScanner(r io.Reader) *Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, MaxLineBytes)
+	return &Scanner{Scanner: s}
+}
+
+func (s *Scanner) Scan() bool {
+	if !s.Scanner.Scan() {
+		return false
+	}
+	s.line++
+	return true
+}
+
+// Line returns the 1-based number of the line last returned by Scan.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Errorf reports a problem at column of the current line.
+func (s *Scanner) Errorf(column int, format string, args ...any) error {
+	return Errorf(s.line, column, format, args...)
+}
+
+// Int parses the field as a base-10 integer, blaming the field's position on
+// the current line when it is not one.
+func (s *Scanner) Int(f Field) (int, error) {
+	n, err := strconv.Atoi(f.Text)
+	if err != nil {
+		return 0, s.Errorf(f.Column, "invalid number %q", f.Text)
+	}
+	return n, nil
+}
+
+// Int64 is Int for values that need all 64 bits.
+func (s *Scanner) Int64(f Field) (int64, error) {
+	n, err := strconv.ParseInt(f.Text, 10, 64)
+	if err != nil {
+		return 0, s.Errorf(f.Column, "invalid number %q", f.Text)
+	}
+	return n, nil
+}
+
+// Field is a piece of a line and the 1-based column it starts at.
+type Field struct {
+	Text   string
+	Column int
+}
+
+// Fields splits a line around runs of whitespace, like strings.Fields, but
+// keeps track of where each field starts.
+func Fields(line string) []Field {
+	var fields []Field
+	start := -1
+	for i, r := range line {
+		switch {
+		case unicode.IsSpace(r) && start >= 0:
+			fields = append(fields, Field{line[start:i], start + 1})
+			start = -1
+		case !unicode.IsSpace(r) && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, Field{line[start:], start + 1})
+	}
+	return fields
+}
+
+// Split splits a line around every instance of sep, keeping track of where
+// each piece starts.
+func Split(line, sep string) []Field {
+	var fields []Field
+	start := 0
+	for {
+		i := strings.Index(line[start:], sep)
+		if i < 0 {
+			return append(fields, Field{line[start:], start + 1})
+		}
+		fields = append(fields, Field{line[start : start+i], start + 1})
+		start += i + len(sep)
+	}
+}
+
+// Match returns the leftmost match of re in line followed by its
+// submatches, or nil when line does not match. Unmatched optional groups are
+// empty fields with a zero Column.
+func Match(re *regexp.Regexp, line string) []Field {
+	loc := re.FindStringSubmatchIndex(line)
+	if loc == nil {
+		return nil
+	}
+
+	fields := make([]Field, len(loc)/2)
+	for i := range fields {
+		if start := loc[2*i]; start >= 0 {
+			fields[i] = Field{line[start:loc[2*i+1]], start + 1}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,207 @@
+// Package render draws text grids, such as the frames of a trace, as PNG
+// images and animated GIFs.
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultCellSize = 4
+	DefaultDelay    = 100 * time.Millisecond
+)
+
+// Unknown is drawn for runes missing from the palette.
+var Unknown color.Color = color.RGBA{0xff, 0x00, 0xff, 0xff}
+
+// Palette maps the runes of a grid to colours.
+type Palette map[rune]color.Color
+
+// DefaultPalette covers the grids of the traced days: the guard's path, the
+// robot swarm's counts, the warehouse and the explored maze.
+var DefaultPalette = Palette{
+	'.': color.RGBA{0x0f, 0x0f, 0x23, 0xff},
+	'#': color.RGBA{0x5a, 0x5a, 0x6e, 0xff},
+	'X': color.RGBA{0xff, 0xcc, 0x00, 0xff},
+	'^': color.RGBA{0xff, 0x33, 0x33, 0xff},
+	'>': color.RGBA{0xff, 0x33, 0x33, 0xff},
+	'v': color.RGBA{0xff, 0x33, 0x33, 0xff},
+	'<': color.RGBA{0xff, 0x33, 0x33, 0xff},
+	'@': color.RGBA{0xff, 0x33, 0x33, 0xff},
+	'O': color.RGBA{0xc8, 0x8c, 0x3c, 0xff},
+	'[': color.RGBA{0xc8, 0x8c, 0x3c, 0xff},
+	']': color.RGBA{0xc8, 0x8c, 0x3c, 0xff},
+	'1': color.RGBA{0x00, 0xcc, 0x00, 0xff},
+	'2': color.RGBA{0x66, 0xff, 0x66, 0xff},
+	'3': color.RGBA{0xcc, 0xff, 0xcc, 0xff},
+	'+': color.RGBA{0xff, 0xff, 0xff, 0xff},
+	'S': color.RGBA{0x33, 0x99, 0xff, 0xff},
+	'E': color.RGBA{0x00, 0xcc, 0x00, 0xff},
+	'o': color.RGBA{0x2a, 0x2a, 0x55, 0xff},
+}
+
+// With returns a copy of p with the colours of o added or replaced.
+func (p Palette) With(o Palette) Palette {
+	merged := make(Palette, len(p)+len(o))
+	for r, c := range p {
+		merged[r] = c
+	}
+	for r, c := range o {
+		merged[r] = c
+	}
+	return merged
+}
+
+// ParsePalette reads comma-separated rune=colour pairs, where a colour is
+// written as hex RGB, such as "#=333,X=ffcc00".
+func ParsePalette(spec string) (Palette, error) {
+	p := make(Palette)
+	if spec == "" {
+		return p, nil
+	}
+	for _, pair := range strings.Split(spec, ",") {
+		ch, hex, ok := strings.Cut(pair, "=")
+		runes := []rune(ch)
+		if !ok || len(runes) != 1 {
+			return nil, fmt.Errorf("palette entry %q: want a single rune=colour", pair)
+		}
+		c, err := parseHex(hex)
+		if err != nil {
+			return nil, fmt.Errorf("palette entry %q: %w", pair, err)
+		}
+		p[runes[0]] = c
+	}
+	return p, nil
+}
+
+func parseHex(s string) (color.Color, error) {
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	n, err := strconv.ParseUint(s, 16, 32)
+	if len(s) != 6 || err != nil {
+		return nil, fmt.Errorf("invalid colour %q, want RGB or RRGGBB in hex", s)
+	}
+	return color.RGBA{uint8(n >> 16), uint8(n >> 8), uint8(n), 0xff}, nil
+}
+
+// Options control how grids are drawn.
+type Options struct {
+	// CellSize is the width and height of a grid cell in pixels. Zero means
+	// DefaultCellSize.
+	CellSize int
+	// Palette colours the grid. Nil means DefaultPalette.
+	Palette Palette
+	// Delay is the time each GIF frame is shown. Zero means DefaultDelay.
+	Delay time.Duration
+}
+
+// painter holds an indexed palette shared by every frame of an image.
+type painter struct {
+	cell    int
+	colours color.Palette
+	index   map[rune]uint8
+}
+
+func newPainter(opts Options) (*painter, error) {
+	palette := opts.Palette
+	if palette == nil {
+		palette = DefaultPalette
+	}
+	if len(palette) > 255 {
+		return nil, fmt.Errorf("render: palette has %d colours, at most 255 fit", len(palette))
+	}
+
+	// Unknown comes first so that it also fills the end of short rows.
+	p := &painter{
+		cell:    opts.CellSize,
+		colours: color.Palette{Unknown},
+		index:   make(map[rune]uint8, len(palette)),
+	}
+	if p.cell <= 0 {
+		p.cell = DefaultCellSize
+	}
+
+	// Sorting the runes keeps the encoded output identical between runs.
+	runes := make([]rune, 0, len(palette))
+	for r := range palette {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+	for _, r := range runes {
+		p.index[r] = uint8(len(p.colours))
+		p.colours = append(p.colours, palette[r])
+	}
+	return p, nil
+}
+
+func (p *painter) paint(rows []string) *image.Paletted {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len([]rune(row)))
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, width*p.cell, len(rows)*p.cell), p.colours)
+	for y, row := range rows {
+		for x, r := range []rune(row) {
+			i := p.index[r]
+			for py := y * p.cell; py < (y+1)*p.cell; py++ {
+				line := img.Pix[py*img.Stride:]
+				for px := x * p.cell; px < (x+1)*p.cell; px++ {
+					line[px] = i
+				}
+			}
+		}
+	}
+	return img
+}
+
+// Image draws a grid.
+func Image(rows []string, opts Options) (*image.Paletted, error) {
+	p, err := newPainter(opts)
+	if err != nil {
+		return nil, err
+	}
+	return p.paint(rows), nil
+}
+
+// PNG writes a grid as a PNG image.
+func PNG(w io.Writer, rows []string, opts Options) error {
+	img, err := Image(rows, opts)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, img)
+}
+
+// GIF writes a sequence of grids as an animated GIF that loops forever.
+func GIF(w io.Writer, frames [][]string, opts Options) error {
+	if len(frames) == 0 {
+		return fmt.Errorf("render: no frames")
+	}
+	p, err := newPainter(opts)
+	if err != nil {
+		return err
+	}
+
+	delay := opts.Delay
+	if delay <= 0 {
+		delay = DefaultDelay
+	}
+
+	anim := &gif.GIF{}
+	for _, rows := range frames {
+		anim.Image = append(anim.Image, p.paint(rows))
+		anim.Delay = append(anim.Delay, int(delay/(10*time.Millisecond)))
+	}
+	return gif.EncodeAll(w, anim)
+}
@@ -0,0 +1,107 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+)
+
+var (
+	black = color.RGBA{0, 0, 0, 0xff}
+	white = color.RGBA{0xff, 0xff, 0xff, 0xff}
+)
+
+func TestImage(t *testing.T) {
+	img, err := Image([]string{"#.", "?"}, Options{CellSize: 2, Palette: Palette{'#': black, '.': white}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 4 || b.Dy() != 4 {
+		t.Fatalf("bounds %v, want 4x4", b)
+	}
+
+	for _, tt := range []struct {
+		x, y int
+		want color.Color
+	}{
+		{0, 0, black}, {1, 1, black},
+		{2, 0, white}, {3, 1, white},
+		{0, 2, Unknown}, {1, 3, Unknown},
+		{2, 2, Unknown}, // past the end of a short row
+	} {
+		if got := img.At(tt.x, tt.y); got != tt.want {
+			t.Errorf("At(%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestPNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := PNG(&buf, []string{"X.", ".X"}, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.Bounds().Dx(); got != 2*DefaultCellSize {
+		t.Errorf("width %d, want %d", got, 2*DefaultCellSize)
+	}
+	r, g, b, _ := img.At(0, 0).RGBA()
+	wr, wg, wb, _ := DefaultPalette['X'].RGBA()
+	if r != wr || g != wg || b != wb {
+		t.Errorf("X drawn as %v", img.At(0, 0))
+	}
+}
+
+func TestGIF(t *testing.T) {
+	frames := [][]string{{"a."}, {".a"}, {"a."}}
+	var buf bytes.Buffer
+	if err := GIF(&buf, frames, Options{Palette: Palette{'a': black, '.': white}}); err != nil {
+		t.Fatal(err)
+	}
+
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anim.Image) != 3 {
+		t.Fatalf("decoded %d frames, want 3", len(anim.Image))
+	}
+	if anim.Delay[0] != 10 {
+		t.Errorf("delay %d, want 10 hundredths", anim.Delay[0])
+	}
+	if got := anim.Image[1].At(DefaultCellSize, 0); got != black {
+		t.Errorf("frame 2 starts with %v, want the moved cell", got)
+	}
+
+	if err := GIF(&buf, nil, Options{}); err == nil {
+		t.Error("GIF of no frames succeeded")
+	}
+}
+
+func TestParsePalette(t *testing.T) {
+	p, err := ParsePalette("#=333,X=ffcc00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p['#'], (color.RGBA{0x33, 0x33, 0x33, 0xff}); got != want {
+		t.Errorf("# = %v, want %v", got, want)
+	}
+	if got, want := p['X'], (color.RGBA{0xff, 0xcc, 0x00, 0xff}); got != want {
+		t.Errorf("X = %v, want %v", got, want)
+	}
+
+	merged := DefaultPalette.With(p)
+	if merged['X'] != p['X'] || merged['.'] != DefaultPalette['.'] {
+		t.Error("With did not override X and keep the rest")
+	}
+
+	for _, bad := range []string{"X", "XY=fff", "X=ggg", "X=12345"} {
+		if _, err := ParsePalette(bad); err == nil {
+			t.Errorf("ParsePalette(%q) succeeded", bad)
+		}
+	}
+}
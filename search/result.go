@@ -0,0 +1,94 @@
+package search
+
+// Result holds everything a search learned: the cheapest known cost to every
+// state it settled and, for each of those, every predecessor that reaches it
+// at that cost. Together the predecessors form a DAG of all shortest paths.
+type Result[S comparable] struct {
+	Dist  map[S]int
+	Preds map[S][]S
+	// Goals lists every goal state reached at the optimal cost, in the order
+	// they were settled.
+	Goals []S
+}
+
+func newResult[S comparable]() *Result[S] {
+	return &Result[S]{
+		Dist:  make(map[S]int),
+		Preds: make(map[S][]S),
+	}
+}
+
+// Cost returns the cost of the cheapest path to any goal.
+func (r *Result[S]) Cost() (int, bool) {
+	if len(r.Goals) == 0 {
+		return 0, false
+	}
+	return r.Dist[r.Goals[0]], true
+}
+
+// Path returns one shortest path from a start state to target, inclusive of
+// both ends, or nil if target was never reached.
+func (r *Result[S]) Path(target S) []S {
+	if _, ok := r.Dist[target]; !ok {
+		return nil
+	}
+
+	path := []S{target}
+	for current := target; len(r.Preds[current]) > 0; {
+		current = r.Preds[current][0]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// OnShortestPaths returns every state that lies on at least one shortest path
+// to any of the given targets.
+func (r *Result[S]) OnShortestPaths(targets ...S) map[S]bool {
+	seen := make(map[S]bool)
+	var stack []S
+	for _, t := range targets {
+		if _, ok := r.Dist[t]; ok && !seen[t] {
+			seen[t] = true
+			stack = append(stack, t)
+		}
+	}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, prev := range r.Preds[current] {
+			if !seen[prev] {
+				seen[prev] = true
+				stack = append(stack, prev)
+			}
+		}
+	}
+	return seen
+}
+
+// AllPaths enumerates every shortest path to target. The number of paths can
+// grow exponentially with the size of the DAG, so prefer OnShortestPaths when
+// only the set of states matters.
+func (r *Result[S]) AllPaths(target S) [][]S {
+	if _, ok := r.Dist[target]; !ok {
+		return nil
+	}
+
+	preds := r.Preds[target]
+	if len(preds) == 0 {
+		return [][]S{{target}}
+	}
+
+	var paths [][]S
+	for _, prev := range preds {
+		for _, path := range r.AllPaths(prev) {
+			paths = append(paths, append(path, target))
+		}
+	}
+	return paths
+}
@@ -0,0 +1,139 @@
+package search
+
+import (
+	"container/heap"
+	"context"
+)
+
+// Edge is a move to a neighbouring state and what it costs. Costs must not be
+// negative.
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// BFS explores a graph with unit edge costs in breadth-first order. A nil goal
+// explores everything reachable; otherwise the search stops once the layer
+// containing the first goal is complete. A search whose context is done stops
+// early with what it has found so far.
+func BFS[S comparable](ctx context.Context, starts []S, neighbours func(S) []S, goal func(S) bool) *Result[S] {
+	res := newResult[S]()
+
+	current := make([]S, 0, len(starts))
+	for _, s := range starts {
+		if _, seen := res.Dist[s]; !seen {
+			res.Dist[s] = 0
+			current = append(current, s)
+		}
+	}
+
+	var next []S
+	for steps := 0; len(current) > 0; steps++ {
+		next = next[:0]
+
+		for _, state := range current {
+			if ctx.Err() != nil {
+				return res
+			}
+			if goal != nil && goal(state) {
+				res.Goals = append(res.Goals, state)
+			}
+
+			for _, n := range neighbours(state) {
+				d, seen := res.Dist[n]
+				switch {
+				case !seen:
+					res.Dist[n] = steps + 1
+					res.Preds[n] = []S{state}
+					next = append(next, n)
+				case d == steps+1:
+					res.Preds[n] = append(res.Preds[n], state)
+				}
+			}
+		}
+
+		if len(res.Goals) > 0 {
+			break
+		}
+		current, next = next, current
+	}
+
+	return res
+}
+
+// Dijkstra finds the cheapest paths through a graph with non-negative edge
+// costs. A nil goal explores everything reachable; otherwise the search stops
+// once every state cheaper than or equal to the best goal has been settled.
+// Like BFS, it stops early once its context is done.
+func Dijkstra[S comparable](ctx context.Context, starts []S, neighbours func(S) []Edge[S], goal func(S) bool) *Result[S] {
+	return AStar(ctx, starts, neighbours, func(S) int { return 0 }, goal)
+}
+
+// AStar is Dijkstra guided by a heuristic. The heuristic must be consistent,
+// that is never decrease by more than the cost of an edge, for the distances
+// and predecessors in the result to be exact.
+func AStar[S comparable](ctx context.Context, starts []S, neighbours func(S) []Edge[S], heuristic func(S) int, goal func(S) bool) *Result[S] {
+	res := newResult[S]()
+	settled := make(map[S]bool)
+	open := &queue[S]{}
+
+	for _, s := range starts {
+		if _, seen := res.Dist[s]; !seen {
+			res.Dist[s] = 0
+			heap.Push(open, item[S]{state: s, priority: heuristic(s)})
+		}
+	}
+
+	best, found := 0, false
+	for open.Len() > 0 && ctx.Err() == nil {
+		current := heap.Pop(open).(item[S])
+		if settled[current.state] {
+			continue
+		}
+		if found && current.priority > best {
+			break
+		}
+		settled[current.state] = true
+
+		cost := res.Dist[current.state]
+		if goal != nil && goal(current.state) {
+			res.Goals = append(res.Goals, current.state)
+			best, found = cost, true
+			continue
+		}
+
+		for _, e := range neighbours(current.state) {
+			next := cost + e.Cost
+			d, seen := res.Dist[e.To]
+			switch {
+			case !seen || next < d:
+				res.Dist[e.To] = next
+				res.Preds[e.To] = []S{current.state}
+				heap.Push(open, item[S]{state: e.To, priority: next + heuristic(e.To)})
+			case next == d:
+				res.Preds[e.To] = append(res.Preds[e.To], current.state)
+			}
+		}
+	}
+
+	return res
+}
+
+type item[S comparable] struct {
+	state    S
+	priority int
+}
+
+type queue[S comparable] []item[S]
+
+func (q queue[S]) Len() int           { return len(q) }
+func (q queue[S]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue[S]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue[S]) Push(x any)        { *q = append(*q, x.(item[S])) }
+func (q *queue[S]) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	*q = old[:n-1]
+	return it
+}
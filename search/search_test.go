@@ -0,0 +1,172 @@
+package search
+
+import (
+	"context"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+)
+
+// diamond has two shortest paths from a to e and a longer way round via f.
+var diamond = map[string][]string{
+	"a": {"b", "c", "f"},
+	"b": {"d"},
+	"c": {"d"},
+	"d": {"e"},
+	"f": {"g"},
+	"g": {"h"},
+	"h": {"e"},
+	"x": {"a"},
+}
+
+func diamondNeighbours(s string) []string { return diamond[s] }
+
+func diamondEdges(s string) []Edge[string] {
+	var edges []Edge[string]
+	for _, to := range diamond[s] {
+		edges = append(edges, Edge[string]{To: to, Cost: 1})
+	}
+	return edges
+}
+
+func is(target string) func(string) bool {
+	return func(s string) bool { return s == target }
+}
+
+func TestBFSDiamond(t *testing.T) {
+	res := BFS(context.Background(), []string{"a"}, diamondNeighbours, is("e"))
+	if cost, ok := res.Cost(); !ok || cost != 3 {
+		t.Fatalf("Cost = %d, %v, want 3", cost, ok)
+	}
+	if got := res.Path("e"); !slices.Equal(got, []string{"a", "b", "d", "e"}) {
+		t.Errorf("Path = %v", got)
+	}
+	want := [][]string{{"a", "b", "d", "e"}, {"a", "c", "d", "e"}}
+	if got := res.AllPaths("e"); !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("AllPaths = %v, want %v", got, want)
+	}
+	on := res.OnShortestPaths("e")
+	if got := slices.Sorted(maps.Keys(on)); !slices.Equal(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("OnShortestPaths = %v", got)
+	}
+	if res.AllPaths("x") != nil || res.Path("x") != nil {
+		t.Error("paths to a state never reached")
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	res := BFS(context.Background(), []string{"a"}, diamondNeighbours, is("x"))
+	if _, ok := res.Cost(); ok {
+		t.Error("BFS reached x")
+	}
+	if len(res.Dist) != 8 {
+		t.Errorf("BFS settled %d states, want all 8 reachable", len(res.Dist))
+	}
+
+	res = Dijkstra(context.Background(), []string{"a"}, diamondEdges, is("x"))
+	if _, ok := res.Cost(); ok || len(res.Dist) != 8 {
+		t.Errorf("Dijkstra reached x or settled %d states", len(res.Dist))
+	}
+}
+
+func TestExploreAll(t *testing.T) {
+	for name, res := range map[string]*Result[string]{
+		"BFS":      BFS(context.Background(), []string{"a"}, diamondNeighbours, nil),
+		"Dijkstra": Dijkstra(context.Background(), []string{"a"}, diamondEdges, nil),
+	} {
+		want := map[string]int{"a": 0, "b": 1, "c": 1, "f": 1, "d": 2, "g": 2, "h": 3, "e": 3}
+		if !maps.Equal(res.Dist, want) || res.Goals != nil {
+			t.Errorf("%s: Dist = %v, Goals = %v", name, res.Dist, res.Goals)
+		}
+		if got := res.Preds["e"]; !slices.Equal(got, []string{"d"}) {
+			t.Errorf("%s: Preds[e] = %v, want [d]", name, got)
+		}
+	}
+}
+
+func TestSeveralStarts(t *testing.T) {
+	res := BFS(context.Background(), []string{"f", "c", "c"}, diamondNeighbours, is("e"))
+	if cost, ok := res.Cost(); !ok || cost != 2 {
+		t.Fatalf("Cost = %d, %v, want 2", cost, ok)
+	}
+	if got := res.AllPaths("e"); !slices.EqualFunc(got, [][]string{{"c", "d", "e"}}, slices.Equal) {
+		t.Errorf("AllPaths = %v", got)
+	}
+
+	res = Dijkstra(context.Background(), []string{"h", "a"}, diamondEdges, is("e"))
+	if cost, _ := res.Cost(); cost != 1 || res.Dist["a"] != 0 || res.Dist["h"] != 0 {
+		t.Errorf("Cost = %d, Dist = %v", cost, res.Dist)
+	}
+}
+
+// TestAStar compares A* with a Manhattan heuristic against Dijkstra on random
+// weighted grids.
+func TestAStar(t *testing.T) {
+	type point struct{ x, y int }
+	const size = 12
+	rng := rand.New(rand.NewPCG(3, 3))
+
+	for round := range 50 {
+		cost := make(map[point]int)
+		for x := range size {
+			for y := range size {
+				cost[point{x, y}] = 1 + rng.IntN(9)
+				if rng.IntN(5) == 0 {
+					cost[point{x, y}] = 0
+				}
+			}
+		}
+		neighbours := func(p point) []Edge[point] {
+			var edges []Edge[point]
+			for _, d := range []point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+				n := point{p.x + d.x, p.y + d.y}
+				if c := cost[n]; c > 0 {
+					edges = append(edges, Edge[point]{To: n, Cost: c})
+				}
+			}
+			return edges
+		}
+		goal := point{size - 1, size - 1}
+		cost[point{0, 0}], cost[goal] = 1, 1
+		isGoal := func(p point) bool { return p == goal }
+		heuristic := func(p point) int { return goal.x - p.x + goal.y - p.y }
+
+		want := Dijkstra(context.Background(), []point{{0, 0}}, neighbours, isGoal)
+		got := AStar(context.Background(), []point{{0, 0}}, neighbours, heuristic, isGoal)
+		wantCost, wantOK := want.Cost()
+		gotCost, gotOK := got.Cost()
+		if gotCost != wantCost || gotOK != wantOK {
+			t.Fatalf("round %d: A* cost %d, %v, Dijkstra %d, %v", round, gotCost, gotOK, wantCost, wantOK)
+		}
+		if !wantOK {
+			continue
+		}
+		wantOn, gotOn := want.OnShortestPaths(goal), got.OnShortestPaths(goal)
+		if !maps.Equal(wantOn, gotOn) {
+			t.Errorf("round %d: A* and Dijkstra disagree on the shortest paths", round)
+		}
+		if g, w := len(got.AllPaths(goal)), len(want.AllPaths(goal)); g != w {
+			t.Errorf("round %d: A* found %d shortest paths, Dijkstra %d", round, g, w)
+		}
+	}
+}
+
+// TestCancel searches an endless line of states, which only a done context
+// can stop.
+func TestCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	next := func(n int) []int { return []int{n + 1} }
+	if res := BFS(ctx, []int{0}, next, func(int) bool { return false }); len(res.Goals) != 0 {
+		t.Errorf("BFS found goals %v", res.Goals)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	edges := func(n int) []Edge[int] { return []Edge[int]{{To: n + 1, Cost: 1}} }
+	if res := Dijkstra(ctx, []int{0}, edges, nil); len(res.Dist) != 1 {
+		t.Errorf("cancelled Dijkstra reached %d states, want only the start", len(res.Dist))
+	}
+}
@@ -0,0 +1,61 @@
+package solver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/reecepm/aoc-2024/vault"
+)
+
+const (
+	InputFile   = "input.txt"
+	ExampleFile = "input.test.txt"
+	// Stdin is the input path that reads from standard input.
+	Stdin = "-"
+)
+
+// InputPath returns where a day's real or example input lives, relative to
+// the repository root.
+func InputPath(day int, example bool) string {
+	name := InputFile
+	if example {
+		name = ExampleFile
+	}
+	return filepath.Join(InputDir(day), name)
+}
+
+// OpenInput opens path for reading, treating Stdin as standard input. When
+// only a sealed copy of the file exists it is decrypted with the vault key.
+func OpenInput(path string) (io.ReadCloser, error) {
+	if path == Stdin {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	f, err := os.Open(path)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return f, err
+	}
+
+	data, sealedErr := vault.ReadSealed(path)
+	if errors.Is(sealedErr, fs.ErrNotExist) {
+		return nil, err
+	}
+	if sealedErr != nil {
+		return nil, sealedErr
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// ReadInput reads the whole of an input opened with OpenInput.
+func ReadInput(path string) ([]byte, error) {
+	f, err := OpenInput(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
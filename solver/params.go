@@ -0,0 +1,197 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/reecepm/aoc-2024/logging"
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+// Param describes one of a puzzle's tunable numbers, such as a grid size or
+// an iteration count that differs between the example and the real input.
+type Param struct {
+	Name    string
+	Usage   string
+	Default int
+	// Example replaces Default when solving an example input. Zero means the
+	// example uses Default too.
+	Example int
+	// Min and Max bound the values New accepts, such as the most iterations
+	// a day's arithmetic can hold. A zero Max leaves it unbounded above.
+	Min, Max int
+}
+
+// Range describes the values the parameter accepts, such as "0..90" or "1..".
+func (p Param) Range() string {
+	if p.Max == 0 {
+		return fmt.Sprintf("%d..", p.Min)
+	}
+	return fmt.Sprintf("%d..%d", p.Min, p.Max)
+}
+
+func (p Param) check(v int) error {
+	if v < p.Min || p.Max != 0 && v > p.Max {
+		return fmt.Errorf("parameter %s is %d, want %s", p.Name, v, p.Range())
+	}
+	return nil
+}
+
+// Params holds parameter values by name.
+type Params map[string]int
+
+// Configurable is implemented by solvers that take parameters. SetParams is
+// called with every declared parameter before Parse.
+type Configurable interface {
+	Solver
+	Params() []Param
+	SetParams(Params)
+}
+
+// Lenient is implemented by solvers whose parser can skip malformed input
+// instead of rejecting it. Solvers without it always parse strictly.
+type Lenient interface {
+	Solver
+	SetMode(parse.Mode)
+}
+
+// Traceable is implemented by step simulations that can record every step of
+// part one to a trace.
+type Traceable interface {
+	Solver
+	SetTracer(*trace.Tracer)
+}
+
+// Referenced is implemented by solvers that also have a slow but obviously
+// correct reference implementation of their parts, which differential tests
+// compare with the real ones.
+type Referenced interface {
+	Solver
+	SetReference(bool)
+}
+
+// Logging is implemented by solvers that log diagnostics at debug level or
+// drawings of their state at trace level while they solve. Solvers created
+// without a logger get one that discards everything.
+type Logging interface {
+	Solver
+	SetLogger(*slog.Logger)
+}
+
+// Reporter is implemented by days that can describe an input in more detail
+// than their answers, such as day 01's comparison of its lists. Report reads
+// the input itself, since a report may accept inputs the puzzle does not.
+type Reporter interface {
+	Solver
+	Report(r io.Reader, w io.Writer) error
+}
+
+// Options control how a solver is created.
+type Options struct {
+	// Example selects the example defaults of every parameter.
+	Example bool
+	// Params overrides individual parameters by name.
+	Params Params
+	// Mode selects strict or lenient parsing.
+	Mode parse.Mode
+	// Tracer records part one of a Traceable solver. Asking to trace any
+	// other solver is an error.
+	Tracer *trace.Tracer
+	// Reference solves with the day's reference implementation instead.
+	// Asking for it on a solver that is not Referenced is an error.
+	Reference bool
+	// Logger receives the diagnostics of a Logging solver. Nil discards them.
+	Logger *slog.Logger
+}
+
+// ParamsOf returns the parameter schema of a day, which is empty for days
+// without parameters.
+func ParamsOf(day int) []Param {
+	s, ok := Lookup(day)
+	if !ok {
+		return nil
+	}
+	if c, ok := s.(Configurable); ok {
+		return c.Params()
+	}
+	return nil
+}
+
+// New returns a solver for the day with its parameters resolved from the
+// defaults and opts. Overriding a parameter the day does not declare, or with
+// a value out of its range, is an error.
+func New(day int, opts Options) (Solver, error) {
+	s, ok := Lookup(day)
+	if !ok {
+		return nil, fmt.Errorf("day %d has no registered solver", day)
+	}
+	if l, ok := s.(Lenient); ok {
+		l.SetMode(opts.Mode)
+	}
+	if opts.Tracer != nil {
+		t, ok := s.(Traceable)
+		if !ok {
+			return nil, fmt.Errorf("day %d cannot be traced", day)
+		}
+		t.SetTracer(opts.Tracer)
+	}
+	if opts.Reference {
+		r, ok := s.(Referenced)
+		if !ok {
+			return nil, fmt.Errorf("day %d has no reference solver", day)
+		}
+		r.SetReference(true)
+	}
+	if l, ok := s.(Logging); ok {
+		logger := opts.Logger
+		if logger == nil {
+			logger = logging.Discard()
+		}
+		l.SetLogger(logger)
+	}
+
+	c, ok := s.(Configurable)
+	if !ok {
+		for name := range opts.Params {
+			return nil, fmt.Errorf("day %d has no parameter %q", day, name)
+		}
+		return s, nil
+	}
+
+	params := make(Params)
+	for _, p := range c.Params() {
+		params[p.Name] = p.Default
+		if opts.Example && p.Example != 0 {
+			params[p.Name] = p.Example
+		}
+	}
+
+	for name, v := range opts.Params {
+		if _, declared := params[name]; !declared {
+			return nil, fmt.Errorf("day %d has no parameter %q", day, name)
+		}
+		params[name] = v
+	}
+
+	var errs []error
+	for _, p := range c.Params() {
+		errs = append(errs, p.check(params[p.Name]))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("day %d: %w", day, err)
+	}
+
+	c.SetParams(params)
+	return c, nil
+}
+
+// IsExamplePath reports whether path names an example input, such as
+// input.test.txt or input.test2.txt.
+func IsExamplePath(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), "input.test")
+}
@@ -0,0 +1,149 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Solver is implemented by every day's puzzle. Parse is always called once
+// before PartOne and PartTwo. Long running parts should give up once their
+// context is done; whatever they return then is discarded.
+type Solver interface {
+	Parse(r io.Reader) error
+	PartOne(ctx context.Context) any
+	PartTwo(ctx context.Context) any
+}
+
+// ErrTimedOut is returned by Run when a part is still running at the
+// context's deadline.
+var ErrTimedOut = errors.New("timed out")
+
+// PartError reports a part that did not finish.
+type PartError struct {
+	Day  int
+	Part int
+	Err  error
+}
+
+func (e *PartError) Error() string {
+	return fmt.Sprintf("day %d part %d: %v", e.Day, e.Part, e.Err)
+}
+
+func (e *PartError) Unwrap() error {
+	return e.Err
+}
+
+// Factory returns a fresh Solver so that runs never share parsed state.
+type Factory func() Solver
+
+var registry = make(map[int]Factory)
+
+// Register makes a day's solver available to the runner. It is intended to be
+// called from the init function of each day's package.
+func Register(day int, factory Factory) {
+	if _, exists := registry[day]; exists {
+		panic(fmt.Sprintf("solver: day %d registered twice", day))
+	}
+	registry[day] = factory
+}
+
+// Lookup returns a new solver for the given day.
+func Lookup(day int) (Solver, bool) {
+	factory, exists := registry[day]
+	if !exists {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// Days returns every registered day in ascending order.
+func Days() []int {
+	days := make([]int, 0, len(registry))
+	for day := range registry {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
+
+// InputDir returns the directory holding a day's inputs, relative to the
+// repository root.
+func InputDir(day int) string {
+	return fmt.Sprintf("day-%02d", day)
+}
+
+type Result struct {
+	Day         int
+	PartOne     any
+	PartTwo     any
+	ParseTime   time.Duration
+	PartOneTime time.Duration
+	PartTwoTime time.Duration
+}
+
+// Run parses the input for a day and solves both parts, timing each step.
+// When ctx ends part way, Run returns the steps that finished along with a
+// *PartError naming the part that did not.
+func Run(ctx context.Context, day int, r io.Reader, opts Options) (*Result, error) {
+	s, err := New(day, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Day: day}
+
+	start := time.Now()
+	if err := s.Parse(r); err != nil {
+		return nil, fmt.Errorf("parsing day %d: %w", day, err)
+	}
+	res.ParseTime = time.Since(start)
+
+	res.PartOne, res.PartOneTime, err = runPart(ctx, s.PartOne)
+	if err != nil {
+		return res, &PartError{day, 1, err}
+	}
+
+	res.PartTwo, res.PartTwoTime, err = runPart(ctx, s.PartTwo)
+	if err != nil {
+		return res, &PartError{day, 2, err}
+	}
+
+	return res, nil
+}
+
+// runPart solves a part in the background, so that a part that never checks
+// its context still cannot hold the caller past the deadline. Days whose
+// parts can run long check it in their loops, so the part itself stops soon
+// after.
+func runPart(ctx context.Context, part func(context.Context) any) (any, time.Duration, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, interrupted(err)
+	}
+
+	done := make(chan any, 1)
+	start := time.Now()
+	go func() { done <- part(ctx) }()
+
+	select {
+	case answer := <-done:
+		took := time.Since(start)
+		// A part that noticed the deadline returns early with a bogus answer.
+		if err := ctx.Err(); err != nil {
+			return nil, took, interrupted(err)
+		}
+		return answer, took, nil
+	case <-ctx.Done():
+		return nil, time.Since(start), interrupted(ctx.Err())
+	}
+}
+
+func interrupted(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimedOut
+	}
+	return err
+}
@@ -0,0 +1,191 @@
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Trace is a recorded simulation.
+type Trace struct {
+	Start Event
+	Steps []Event
+}
+
+// Read decodes a trace written by a Tracer. Numbers in the state are kept as
+// json.Number so that large values print exactly.
+func Read(r io.Reader) (*Trace, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	t := new(Trace)
+	for n := 1; ; n++ {
+		var e Event
+		if err := dec.Decode(&e); err != nil {
+			if errors.Is(err, io.EOF) && n > 1 {
+				return t, nil
+			}
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("trace: empty")
+			}
+			return nil, fmt.Errorf("trace: event %d: %w", n, err)
+		}
+
+		switch {
+		case n == 1 && e.Kind != KindStart:
+			return nil, fmt.Errorf("trace: event 1 is %q, want %q", e.Kind, KindStart)
+		case n == 1:
+			t.Start = e
+		case e.Kind != KindStep:
+			return nil, fmt.Errorf("trace: event %d is %q, want %q", n, e.Kind, KindStep)
+		default:
+			t.Steps = append(t.Steps, e)
+		}
+	}
+}
+
+// Player steps forwards and backwards through a trace. Frame 0 is the start
+// event and frame n is the state after step n.
+type Player struct {
+	trace  *Trace
+	frame  int
+	grid   [][]rune
+	undo   [][]Cell
+	states []map[string]any
+}
+
+func NewPlayer(t *Trace) *Player {
+	p := &Player{
+		trace:  t,
+		undo:   make([][]Cell, len(t.Steps)),
+		states: make([]map[string]any, len(t.Steps)+1),
+	}
+	for _, row := range t.Start.Grid {
+		p.grid = append(p.grid, []rune(row))
+	}
+
+	// Replaying every step once records what each one overwrote, so that
+	// stepping backwards is as cheap as stepping forwards.
+	p.states[0] = maps.Clone(t.Start.State)
+	for i, step := range t.Steps {
+		p.undo[i] = p.apply(step.Set)
+		p.states[i+1] = maps.Clone(p.states[i])
+		if p.states[i+1] == nil {
+			p.states[i+1] = make(map[string]any)
+		}
+		maps.Copy(p.states[i+1], step.State)
+	}
+	for i := len(t.Steps) - 1; i >= 0; i-- {
+		p.apply(p.undo[i])
+	}
+	return p
+}
+
+// apply writes cells to the grid and returns the cells they replaced.
+func (p *Player) apply(cells []Cell) []Cell {
+	old := make([]Cell, 0, len(cells))
+	for _, c := range cells {
+		if c.Y < 0 || c.Y >= len(p.grid) || c.X < 0 || c.X >= len(p.grid[c.Y]) {
+			continue
+		}
+		old = append(old, Cell{c.X, c.Y, string(p.grid[c.Y][c.X])})
+	}
+	for _, c := range cells {
+		if c.Y < 0 || c.Y >= len(p.grid) || c.X < 0 || c.X >= len(p.grid[c.Y]) {
+			continue
+		}
+		if r := []rune(c.Ch); len(r) > 0 {
+			p.grid[c.Y][c.X] = r[0]
+		}
+	}
+	return old
+}
+
+// Frame returns the current frame number.
+func (p *Player) Frame() int {
+	return p.frame
+}
+
+// Len returns the number of steps, which is also the last frame number.
+func (p *Player) Len() int {
+	return len(p.trace.Steps)
+}
+
+// Next advances one frame and reports whether there was one.
+func (p *Player) Next() bool {
+	if p.frame == p.Len() {
+		return false
+	}
+	p.apply(p.trace.Steps[p.frame].Set)
+	p.frame++
+	return true
+}
+
+// Prev goes back one frame and reports whether there was one.
+func (p *Player) Prev() bool {
+	if p.frame == 0 {
+		return false
+	}
+	p.frame--
+	p.apply(p.undo[p.frame])
+	return true
+}
+
+// Seek moves to the given frame, clamped to the trace.
+func (p *Player) Seek(frame int) {
+	for p.frame < frame && p.Next() {
+	}
+	for p.frame > frame && p.Prev() {
+	}
+}
+
+// Grid returns the grid of the current frame.
+func (p *Player) Grid() []string {
+	rows := make([]string, len(p.grid))
+	for y, row := range p.grid {
+		rows[y] = string(row)
+	}
+	return rows
+}
+
+// State returns every state value as of the current frame.
+func (p *Player) State() map[string]any {
+	return p.states[p.frame]
+}
+
+// Note returns the note of the step that produced the current frame.
+func (p *Player) Note() string {
+	if p.frame == 0 {
+		return "start"
+	}
+	return p.trace.Steps[p.frame-1].Note
+}
+
+// Render writes the current frame as text.
+func (p *Player) Render(w io.Writer) error {
+	var b strings.Builder
+	start := p.trace.Start
+	fmt.Fprintf(&b, "day %02d %s: step %d/%d: %s\n", start.Day, start.Title, p.frame, p.Len(), p.Note())
+	for _, row := range p.grid {
+		b.WriteString(string(row))
+		b.WriteByte('\n')
+	}
+
+	state := p.State()
+	for i, key := range slices.Sorted(maps.Keys(state)) {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%s=%v", key, state[key])
+	}
+	if len(state) > 0 {
+		b.WriteByte('\n')
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,142 @@
+// Package trace records step simulations as JSON lines so that they can be
+// replayed later.
+//
+// A trace starts with a single KindStart event holding the day, a title, the
+// initial grid (if the simulation has one) and the initial state. Every
+// following line is a KindStep event holding only what changed: the grid
+// cells that were overwritten and the state values that were set.
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	KindStart = "start"
+	KindStep  = "step"
+)
+
+// Cell is a grid cell overwritten by a step.
+type Cell struct {
+	X  int    `json:"x"`
+	Y  int    `json:"y"`
+	Ch string `json:"ch"`
+}
+
+// Event is one line of a trace.
+type Event struct {
+	Kind  string         `json:"kind"`
+	Step  int            `json:"step,omitempty"`
+	Day   int            `json:"day,omitempty"`
+	Title string         `json:"title,omitempty"`
+	Grid  []string       `json:"grid,omitempty"`
+	Note  string         `json:"note,omitempty"`
+	Set   []Cell         `json:"set,omitempty"`
+	State map[string]any `json:"state,omitempty"`
+}
+
+// Tracer turns the frames of a simulation into events. Simulations pass it
+// their whole grid at every step and the tracer works out which cells
+// changed.
+type Tracer struct {
+	emit  func(Event) error
+	rows  [][]rune
+	steps int
+	err   error
+}
+
+// New returns a tracer that hands every event to emit. Once emit fails, the
+// remaining events are dropped and Err reports the failure.
+func New(emit func(Event) error) *Tracer {
+	return &Tracer{emit: emit}
+}
+
+// NewWriter returns a tracer that writes events to w as JSON lines.
+func NewWriter(w io.Writer) *Tracer {
+	enc := json.NewEncoder(w)
+	return New(func(e Event) error { return enc.Encode(e) })
+}
+
+// Start records the initial frame. grid may be nil for simulations that only
+// have state.
+func (t *Tracer) Start(day int, title string, grid []string, state map[string]any) {
+	t.rows = make([][]rune, len(grid))
+	for y, row := range grid {
+		t.rows[y] = []rune(row)
+	}
+	t.steps = 0
+	t.send(Event{Kind: KindStart, Day: day, Title: title, Grid: grid, State: state})
+}
+
+// Step records the next frame. grid may be nil when the grid did not change;
+// state only needs the values that changed.
+func (t *Tracer) Step(note string, grid []string, state map[string]any) {
+	t.steps++
+	t.send(Event{Kind: KindStep, Step: t.steps, Note: note, Set: t.diff(grid), State: state})
+}
+
+// Change records the next frame from the cells a simulation knows it
+// overwrote, which saves redrawing a large grid at every step.
+func (t *Tracer) Change(note string, set []Cell, state map[string]any) {
+	for _, c := range set {
+		if c.Y >= 0 && c.Y < len(t.rows) && c.X >= 0 && c.X < len(t.rows[c.Y]) {
+			if r := []rune(c.Ch); len(r) > 0 {
+				t.rows[c.Y][c.X] = r[0]
+			}
+		}
+	}
+	t.steps++
+	t.send(Event{Kind: KindStep, Step: t.steps, Note: note, Set: set, State: state})
+}
+
+// Steps returns the number of steps recorded since Start.
+func (t *Tracer) Steps() int {
+	return t.steps
+}
+
+// Err returns the first error returned while emitting an event.
+func (t *Tracer) Err() error {
+	return t.err
+}
+
+func (t *Tracer) send(e Event) {
+	if t.err != nil {
+		return
+	}
+	if err := t.emit(e); err != nil {
+		t.err = fmt.Errorf("trace: step %d: %w", e.Step, err)
+	}
+}
+
+func (t *Tracer) diff(grid []string) []Cell {
+	var set []Cell
+	for y, row := range grid {
+		if y >= len(t.rows) {
+			break
+		}
+		for x, ch := range []rune(row) {
+			if x < len(t.rows[y]) && t.rows[y][x] != ch {
+				t.rows[y][x] = ch
+				set = append(set, Cell{x, y, string(ch)})
+			}
+		}
+	}
+	return set
+}
+
+// Rows renders a width by height grid, asking at for the rune of each cell.
+func Rows(width, height int, at func(x, y int) rune) []string {
+	rows := make([]string, height)
+	var b strings.Builder
+	for y := range rows {
+		b.Reset()
+		for x := 0; x < width; x++ {
+			b.WriteRune(at(x, y))
+		}
+		rows[y] = b.String()
+	}
+	return rows
+}
@@ -0,0 +1,116 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func record(t *testing.T) (*Trace, [][]string) {
+	t.Helper()
+
+	frames := [][]string{
+		{"a..", "..."},
+		{".a.", "..."},
+		{"..a", "..."},
+		{"..a", "..b"},
+	}
+
+	var buf bytes.Buffer
+	tr := NewWriter(&buf)
+	tr.Start(1, "test", frames[0], map[string]any{"n": 0})
+	tr.Step("right", frames[1], map[string]any{"n": 1})
+	tr.Change("right", []Cell{{1, 0, "."}, {2, 0, "a"}}, nil)
+	tr.Step("spawn", frames[3], map[string]any{"n": 3, "spawned": true})
+	if err := tr.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	trace, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trace, frames
+}
+
+func TestRecordOnlyChanges(t *testing.T) {
+	trace, _ := record(t)
+	if len(trace.Steps) != 3 {
+		t.Fatalf("read %d steps, want 3", len(trace.Steps))
+	}
+	want := []Cell{{0, 0, "."}, {1, 0, "a"}}
+	if got := trace.Steps[0].Set; !slices.Equal(got, want) {
+		t.Errorf("step 1 set %v, want %v", got, want)
+	}
+	if got := trace.Steps[2].Set; !slices.Equal(got, []Cell{{2, 1, "b"}}) {
+		t.Errorf("step 3 set %v, want only the new cell", got)
+	}
+}
+
+func TestPlayer(t *testing.T) {
+	trace, frames := record(t)
+	p := NewPlayer(trace)
+
+	for i := range frames {
+		p.Seek(i)
+		if got := p.Grid(); !slices.Equal(got, frames[i]) {
+			t.Errorf("forwards to frame %d: %q, want %q", i, got, frames[i])
+		}
+	}
+	if p.Next() {
+		t.Error("Next past the last frame succeeded")
+	}
+
+	for i := len(frames) - 1; i >= 0; i-- {
+		p.Seek(i)
+		if got := p.Grid(); !slices.Equal(got, frames[i]) {
+			t.Errorf("backwards to frame %d: %q, want %q", i, got, frames[i])
+		}
+	}
+	if p.Prev() {
+		t.Error("Prev before the first frame succeeded")
+	}
+
+	// State carries forward until a step overwrites it.
+	p.Seek(2)
+	if got := p.State()["n"]; got != json.Number("1") {
+		t.Errorf("n at frame 2 = %v, want 1", got)
+	}
+	p.Seek(3)
+	if got := p.State()["spawned"]; got != true {
+		t.Errorf("spawned at frame 3 = %v, want true", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	trace, _ := record(t)
+	p := NewPlayer(trace)
+	p.Seek(3)
+
+	var b strings.Builder
+	if err := p.Render(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := "day 01 test: step 3/3: spawn\n..a\n..b\nn=3 spawned=true\n"
+	if b.String() != want {
+		t.Errorf("Render = %q, want %q", b.String(), want)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name, input, want string
+	}{
+		{"empty", "", "trace: empty"},
+		{"no start", `{"kind":"step","step":1}`, `event 1 is "step"`},
+		{"second start", `{"kind":"start"}` + "\n" + `{"kind":"start"}`, `event 2 is "start"`},
+		{"bad json", `{"kind":"start"}` + "\n{", "event 2"},
+	} {
+		_, err := Read(strings.NewReader(tt.input))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want it to mention %q", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// KeyEnv holds the hex encoded 256-bit key that inputs are sealed with.
+	KeyEnv = "AOC_INPUT_KEY"
+	// Ext is appended to the name of a sealed file.
+	Ext = ".enc"
+
+	KeySize = 32
+)
+
+// magic starts every sealed file so that a stray plain file is rejected with
+// a clear error rather than a failed decryption.
+var magic = []byte("aocvault1\n")
+
+var (
+	ErrNoKey   = errors.New("inputs are encrypted; set " + KeyEnv)
+	ErrBadKey  = fmt.Errorf("%s must be %d hex encoded bytes", KeyEnv, KeySize)
+	ErrCorrupt = errors.New("sealed input is corrupt or was sealed with another key")
+)
+
+// Vault seals and opens puzzle inputs with AES-256-GCM.
+type Vault struct {
+	aead cipher.AEAD
+}
+
+func New(key []byte) (*Vault, error) {
+	if len(key) != KeySize {
+		return nil, ErrBadKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Vault{aead: aead}, nil
+}
+
+// FromEnv builds a vault from the key in AOC_INPUT_KEY.
+func FromEnv() (*Vault, error) {
+	encoded := strings.TrimSpace(os.Getenv(KeyEnv))
+	if encoded == "" {
+		return nil, ErrNoKey
+	}
+
+	key, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrBadKey
+	}
+	return New(key)
+}
+
+// GenerateKey returns a new random key, hex encoded for use in AOC_INPUT_KEY.
+func GenerateKey() (string, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// Seal encrypts data. The name, such as "day-05/input.txt", is authenticated
+// along with it so that sealed files cannot be swapped between days.
+func (v *Vault) Seal(name string, data []byte) ([]byte, error) {
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := append(bytes.Clone(magic), nonce...)
+	return v.aead.Seal(out, nonce, data, []byte(name)), nil
+}
+
+// Open decrypts data sealed under the same name.
+func (v *Vault) Open(name string, sealed []byte) ([]byte, error) {
+	rest, ok := bytes.CutPrefix(sealed, magic)
+	if !ok || len(rest) < v.aead.NonceSize() {
+		return nil, ErrCorrupt
+	}
+
+	nonce, ciphertext := rest[:v.aead.NonceSize()], rest[v.aead.NonceSize():]
+	data, err := v.aead.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	return data, nil
+}
+
+// Name identifies a file by its directory and base name, such as
+// "day-05/input.txt", which stays the same wherever the repository is opened
+// from.
+func Name(path string) string {
+	path = strings.TrimSuffix(path, Ext)
+	return filepath.ToSlash(filepath.Join(filepath.Base(filepath.Dir(path)), filepath.Base(path)))
+}
+
+// ReadSealed decrypts path+Ext with the key from the environment. It returns
+// an error satisfying errors.Is(err, fs.ErrNotExist) when there is no sealed
+// copy.
+func ReadSealed(path string) ([]byte, error) {
+	sealed, err := os.ReadFile(path + Ext)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := FromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path+Ext, err)
+	}
+
+	data, err := v.Open(Name(path), sealed)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path+Ext, err)
+	}
+	return data, nil
+}
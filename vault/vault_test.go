@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestVault(t *testing.T) (*Vault, string) {
+	t.Helper()
+
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(KeyEnv, key)
+
+	v, err := FromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v, key
+}
+
+func TestSealOpen(t *testing.T) {
+	v, _ := newTestVault(t)
+	input := []byte("3   4\n4   3\n")
+
+	sealed, err := v.Seal("day-01/input.txt", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sealed, input) {
+		t.Fatal("sealed data contains the plain input")
+	}
+
+	got, err := v.Open("day-01/input.txt", sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, input) {
+		t.Errorf("Open = %q, want %q", got, input)
+	}
+
+	if _, err := v.Open("day-02/input.txt", sealed); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("opening under another name: err = %v, want ErrCorrupt", err)
+	}
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := v.Open("day-01/input.txt", tampered); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("opening tampered data: err = %v, want ErrCorrupt", err)
+	}
+
+	other, _ := newTestVault(t)
+	if _, err := other.Open("day-01/input.txt", sealed); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("opening with another key: err = %v, want ErrCorrupt", err)
+	}
+
+	if _, err := v.Open("day-01/input.txt", input); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("opening a plain file: err = %v, want ErrCorrupt", err)
+	}
+}
+
+func TestFromEnvKeys(t *testing.T) {
+	tests := []struct {
+		key  string
+		want error
+	}{
+		{"", ErrNoKey},
+		{"not hex", ErrBadKey},
+		{"abcd", ErrBadKey},
+	}
+
+	for _, tt := range tests {
+		t.Setenv(KeyEnv, tt.key)
+		if _, err := FromEnv(); !errors.Is(err, tt.want) {
+			t.Errorf("key %q: err = %v, want %v", tt.key, err, tt.want)
+		}
+	}
+}
+
+func TestReadSealed(t *testing.T) {
+	v, _ := newTestVault(t)
+	dir := filepath.Join(t.TempDir(), "day-07")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "input.txt")
+
+	if _, err := ReadSealed(path); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("missing sealed file: err = %v, want fs.ErrNotExist", err)
+	}
+
+	sealed, err := v.Seal("day-07/input.txt", []byte("190: 10 19\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path+Ext, sealed, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadSealed(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "190: 10 19\n" {
+		t.Errorf("ReadSealed = %q", got)
+	}
+}
+
+func TestName(t *testing.T) {
+	for _, path := range []string{"day-05/input.txt", "../day-05/input.txt", "/src/aoc/day-05/input.txt.enc"} {
+		if got := Name(path); got != "day-05/input.txt" {
+			t.Errorf("Name(%q) = %q", path, got)
+		}
+	}
+}
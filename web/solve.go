@@ -0,0 +1,186 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reecepm/aoc-2024/parse"
+	"github.com/reecepm/aoc-2024/solver"
+)
+
+// Part is the answer to one part of a puzzle and how long it took.
+type Part struct {
+	Answer string `json:"answer"`
+	TimeNs int64  `json:"timeNs"`
+}
+
+// Error describes why a solve failed. Line and Column locate parse errors,
+// and Part names the part that timed out.
+type Error struct {
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Part    int    `json:"part,omitempty"`
+}
+
+// Solution is the response to POST /days/{n}/solve. A run that times out
+// still reports the steps that finished.
+type Solution struct {
+	Day         int    `json:"day"`
+	ParseTimeNs int64  `json:"parseTimeNs,omitempty"`
+	PartOne     *Part  `json:"partOne,omitempty"`
+	PartTwo     *Part  `json:"partTwo,omitempty"`
+	Error       *Error `json:"error,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func fail(w http.ResponseWriter, code int, day int, format string, args ...any) {
+	writeJSON(w, code, Solution{Day: day, Error: &Error{Message: fmt.Sprintf(format, args...)}})
+}
+
+// solveOptions reads the query of a solve request: repeated param=name=value
+// overrides, example=true for the example defaults, lenient=true for lenient
+// parsing and timeout=10s to shorten the server's limit.
+func solveOptions(r *http.Request, limit time.Duration) (solver.Options, time.Duration, error) {
+	query := r.URL.Query()
+	var opts solver.Options
+
+	for _, kv := range query["param"] {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || name == "" {
+			return opts, 0, fmt.Errorf("param %q: want name=value", kv)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return opts, 0, fmt.Errorf("param %q: value must be an integer", kv)
+		}
+		if opts.Params == nil {
+			opts.Params = make(solver.Params)
+		}
+		opts.Params[name] = n
+	}
+
+	var err error
+	if opts.Example, err = queryBool(query, "example"); err != nil {
+		return opts, 0, err
+	}
+	lenient, err := queryBool(query, "lenient")
+	if err != nil {
+		return opts, 0, err
+	}
+	if lenient {
+		opts.Mode = parse.Lenient
+	}
+
+	timeout := limit
+	if v := query.Get("timeout"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return opts, 0, fmt.Errorf("timeout %q: want a positive duration", v)
+		}
+		timeout = min(d, limit)
+	}
+	return opts, timeout, nil
+}
+
+func queryBool(query url.Values, name string) (bool, error) {
+	v := query.Get(name)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%s %q: want true or false", name, v)
+	}
+	return b, nil
+}
+
+func (s *Server) solve(w http.ResponseWriter, r *http.Request) {
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil {
+		fail(w, http.StatusBadRequest, 0, "invalid day %q", r.PathValue("day"))
+		return
+	}
+	if _, ok := solver.Lookup(day); !ok {
+		fail(w, http.StatusNotFound, day, "no solver registered for day %d", day)
+		return
+	}
+
+	opts, timeout, err := solveOptions(r, s.timeout())
+	if err != nil {
+		fail(w, http.StatusBadRequest, day, "%v", err)
+		return
+	}
+	// Checking the options up front tells a bad parameter apart from a parse
+	// error below.
+	if _, err := solver.New(day, opts); err != nil {
+		fail(w, http.StatusBadRequest, day, "%v", err)
+		return
+	}
+
+	limit := s.MaxInputBytes
+	if limit <= 0 {
+		limit = DefaultMaxInputBytes
+	}
+	input, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		fail(w, http.StatusRequestEntityTooLarge, day, "input is larger than %d bytes", limit)
+		return
+	}
+	if err != nil {
+		fail(w, http.StatusBadRequest, day, "reading input: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+	res, err := solver.Run(ctx, day, bytes.NewReader(input), opts)
+
+	sol := Solution{Day: day}
+	if res != nil {
+		sol.ParseTimeNs = res.ParseTime.Nanoseconds()
+		if res.PartOne != nil {
+			sol.PartOne = &Part{fmt.Sprint(res.PartOne), res.PartOneTime.Nanoseconds()}
+		}
+		if res.PartTwo != nil {
+			sol.PartTwo = &Part{fmt.Sprint(res.PartTwo), res.PartTwoTime.Nanoseconds()}
+		}
+	}
+
+	var (
+		perr  *solver.PartError
+		parsE *parse.Error
+	)
+	switch {
+	case err == nil:
+		writeJSON(w, http.StatusOK, sol)
+	case errors.As(err, &perr):
+		sol.Error = &Error{Message: err.Error(), Part: perr.Part}
+		code := http.StatusGatewayTimeout
+		if !errors.Is(err, solver.ErrTimedOut) {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, sol)
+	case errors.As(err, &parsE):
+		sol.Error = &Error{Message: err.Error(), Line: parsE.Line, Column: parsE.Column}
+		writeJSON(w, http.StatusUnprocessableEntity, sol)
+	default:
+		sol.Error = &Error{Message: err.Error()}
+		writeJSON(w, http.StatusUnprocessableEntity, sol)
+	}
+}
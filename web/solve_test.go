@@ -0,0 +1,100 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func post(t *testing.T, srv *httptest.Server, path, input string) (int, Solution) {
+	t.Helper()
+	resp, err := http.Post(srv.URL+path, "text/plain", strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var sol Solution
+	if err := json.NewDecoder(resp.Body).Decode(&sol); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, sol
+}
+
+func example(t *testing.T, day string) string {
+	t.Helper()
+	b, err := os.ReadFile("../day-" + day + "/input.test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestSolve(t *testing.T) {
+	srv := newServer(t)
+	code, sol := post(t, srv, "/days/6/solve", example(t, "06"))
+	if code != http.StatusOK || sol.Error != nil {
+		t.Fatalf("status %d, error %+v", code, sol.Error)
+	}
+	if sol.Day != 6 || sol.PartOne == nil || sol.PartOne.Answer != "41" || sol.PartTwo == nil || sol.PartTwo.Answer != "6" {
+		t.Errorf("solution %+v, want 41 and 6", sol)
+	}
+}
+
+func TestSolveParams(t *testing.T) {
+	srv := newServer(t)
+	input := example(t, "14")
+
+	code, sol := post(t, srv, "/days/14/solve?param=width=11&param=height=7", input)
+	if code != http.StatusOK || sol.PartOne.Answer != "12" {
+		t.Errorf("with overrides: status %d, %+v", code, sol)
+	}
+	code, sol = post(t, srv, "/days/14/solve?example=true", input)
+	if code != http.StatusOK || sol.PartOne.Answer != "12" {
+		t.Errorf("with example defaults: status %d, %+v", code, sol)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	srv := httptest.NewServer((&Server{MaxInputBytes: 64}).Handler())
+	t.Cleanup(srv.Close)
+
+	for _, tt := range []struct {
+		name, path, input string
+		code              int
+		check             func(Solution) bool
+	}{
+		{"bad day", "/days/x/solve", "", http.StatusBadRequest, nil},
+		{"no solver", "/days/99/solve", "", http.StatusNotFound, nil},
+		{"unknown param", "/days/6/solve?param=width=3", "", http.StatusBadRequest, nil},
+		{"bad param", "/days/14/solve?param=width", "", http.StatusBadRequest, nil},
+		{"param too large", "/days/22/solve?param=iterations=1000000000000000", "", http.StatusBadRequest, nil},
+		{"too many robots", "/days/21/solve?param=part1-robots=100000000", "", http.StatusBadRequest, nil},
+		{"bad timeout", "/days/6/solve?timeout=never", "", http.StatusBadRequest, nil},
+		{
+			"parse error", "/days/1/solve", "3   4\nx   3\n", http.StatusUnprocessableEntity,
+			func(s Solution) bool { return s.Error.Line == 2 && s.Error.Column == 1 && s.PartOne == nil },
+		},
+		{
+			"lenient", "/days/1/solve?lenient=true", "3   4\nx   3\n", http.StatusOK,
+			func(s Solution) bool { return s.PartOne.Answer == "1" },
+		},
+		{
+			"timeout", "/days/6/solve?timeout=1ns", "^", http.StatusGatewayTimeout,
+			func(s Solution) bool { return s.Error.Part == 1 },
+		},
+		{"too large", "/days/1/solve", strings.Repeat("1   2\n", 20), http.StatusRequestEntityTooLarge, nil},
+	} {
+		code, sol := post(t, srv, tt.path, tt.input)
+		if code != tt.code || (tt.code != http.StatusOK && sol.Error == nil) {
+			t.Errorf("%s: status %d with error %+v, want %d", tt.name, code, sol.Error, tt.code)
+			continue
+		}
+		if tt.check != nil && !tt.check(sol) {
+			t.Errorf("%s: unexpected solution %+v (error %+v)", tt.name, sol, sol.Error)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Package web serves the solvers over HTTP: a JSON API that solves any day
+// from a posted input, and a browser visualizer that streams the steps of the
+// traceable simulation days as Server-Sent Events.
+package web
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+//go:embed index.html
+var index []byte
+
+const (
+	DefaultTimeout = time.Minute
+	// MaxDelay caps the pause a client may ask for between steps.
+	MaxDelay = time.Second
+	// DefaultMaxInputBytes bounds the input a client may post.
+	DefaultMaxInputBytes = 16 << 20
+)
+
+// Server solves posted inputs and streams simulations of the inputs under
+// Root.
+type Server struct {
+	// Root is the directory holding the day-NN input directories.
+	Root string
+	// Timeout limits each run and caps the timeout a solve request may ask
+	// for. Zero means DefaultTimeout.
+	Timeout time.Duration
+	// MaxInputBytes bounds posted inputs. Zero means DefaultMaxInputBytes.
+	MaxInputBytes int64
+}
+
+// Handler returns the server's routes:
+//
+//	GET  /                  the visualizer page
+//	GET  /days              the days that can be traced, as JSON
+//	GET  /days/{n}/events   a run of day n as an event stream
+//	POST /days/{n}/solve    solve the posted input for day n, as JSON
+//
+// The event stream takes ?example=true to use the example input and
+// ?delay=50ms to pace the steps.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.index)
+	mux.HandleFunc("GET /days", s.days)
+	mux.HandleFunc("GET /days/{day}/events", s.events)
+	mux.HandleFunc("POST /days/{day}/solve", s.solve)
+	return mux
+}
+
+func (s *Server) timeout() time.Duration {
+	if s.Timeout <= 0 {
+		return DefaultTimeout
+	}
+	return s.Timeout
+}
+
+func (s *Server) index(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(index)
+}
+
+// TracedDays returns the registered days that can record a trace.
+func TracedDays() []int {
+	var days []int
+	for _, day := range solver.Days() {
+		if s, ok := solver.Lookup(day); ok {
+			if _, ok := s.(solver.Traceable); ok {
+				days = append(days, day)
+			}
+		}
+	}
+	return days
+}
+
+func (s *Server) days(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TracedDays())
+}
+
+// errStreamClosed stops a tracer that outlives its event stream.
+var errStreamClosed = errors.New("event stream closed")
+
+// Done is the data of the final event of a successful run.
+type Done struct {
+	Steps   int    `json:"steps"`
+	PartOne string `json:"partOne"`
+	PartTwo string `json:"partTwo"`
+}
+
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid day %q", r.PathValue("day")), http.StatusBadRequest)
+		return
+	}
+	sv, ok := solver.Lookup(day)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no solver registered for day %d", day), http.StatusNotFound)
+		return
+	}
+	if _, ok := sv.(solver.Traceable); !ok {
+		http.Error(w, fmt.Sprintf("day %d cannot be traced", day), http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	example, _ := strconv.ParseBool(query.Get("example"))
+	var delay time.Duration
+	if d := query.Get("delay"); d != "" {
+		if delay, err = time.ParseDuration(d); err != nil || delay < 0 {
+			http.Error(w, fmt.Sprintf("invalid delay %q", d), http.StatusBadRequest)
+			return
+		}
+		delay = min(delay, MaxDelay)
+	}
+
+	f, err := solver.OpenInput(filepath.Join(s.Root, solver.InputPath(day, example)))
+	if errors.Is(err, fs.ErrNotExist) {
+		http.Error(w, fmt.Sprintf("day %d has no input", day), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout())
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	// The tracer runs on the solver's goroutine, which runs on after a part
+	// times out, so events are written one at a time and none follow the
+	// handler's last.
+	var (
+		mu     sync.Mutex
+		closed bool
+	)
+	write := func(name string, id int, data any) error {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if id > 0 {
+			fmt.Fprintf(w, "id: %d\n", id)
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, b); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	send := func(name string, id int, data any) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return errStreamClosed
+		}
+		return write(name, id, data)
+	}
+	finish := func(name string, data any) {
+		mu.Lock()
+		defer mu.Unlock()
+		closed = true
+		if name != "" {
+			write(name, 0, data)
+		}
+	}
+	defer finish("", nil)
+
+	// A client that goes away stops the stream; the solver itself notices
+	// through ctx at its next check.
+	tracer := trace.New(func(e trace.Event) error {
+		if delay > 0 && e.Kind == trace.KindStep {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return send(e.Kind, e.Step, e)
+	})
+
+	res, err := solver.Run(ctx, day, f, solver.Options{Example: example, Tracer: tracer})
+	if r.Context().Err() != nil {
+		return
+	}
+	if err == nil {
+		err = tracer.Err()
+	}
+	if err != nil {
+		finish("error", map[string]string{"error": err.Error()})
+		return
+	}
+	finish("done", Done{Steps: tracer.Steps(), PartOne: fmt.Sprint(res.PartOne), PartTwo: fmt.Sprint(res.PartTwo)})
+}
@@ -0,0 +1,183 @@
+package web
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/reecepm/aoc-2024/days"
+	"github.com/reecepm/aoc-2024/gen"
+	"github.com/reecepm/aoc-2024/solver"
+	"github.com/reecepm/aoc-2024/trace"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer((&Server{Root: ".."}).Handler())
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+type sse struct {
+	name string
+	data string
+}
+
+// readEvents reads a whole event stream.
+func readEvents(t *testing.T, r io.Reader) []sse {
+	t.Helper()
+	var events []sse
+	var current sse
+	lines := bufio.NewScanner(r)
+	lines.Buffer(nil, 1<<20)
+	for lines.Scan() {
+		field, value, _ := strings.Cut(lines.Text(), ": ")
+		switch field {
+		case "event":
+			current.name = value
+		case "data":
+			current.data = value
+		case "":
+			events = append(events, current)
+			current = sse{}
+		}
+	}
+	if err := lines.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+func TestIndex(t *testing.T) {
+	srv := newServer(t)
+	resp, err := http.Get(srv.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "EventSource") {
+		t.Errorf("GET / = %d, want the visualizer page", resp.StatusCode)
+	}
+}
+
+func TestDays(t *testing.T) {
+	srv := newServer(t)
+	resp, err := http.Get(srv.URL + "/days")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var days []int
+	if err := json.NewDecoder(resp.Body).Decode(&days); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []int{6, 14, 15, 16} {
+		if !slices.Contains(days, want) {
+			t.Errorf("days %v is missing %d", days, want)
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	srv := newServer(t)
+	resp, err := http.Get(srv.URL + "/days/6/events?example=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+
+	events := readEvents(t, resp.Body)
+	if len(events) < 3 || events[0].name != "start" || events[len(events)-1].name != "done" {
+		t.Fatalf("got %d events, want start, steps and done", len(events))
+	}
+
+	var start trace.Event
+	if err := json.Unmarshal([]byte(events[0].data), &start); err != nil {
+		t.Fatal(err)
+	}
+	if start.Day != 6 || len(start.Grid) != 10 {
+		t.Errorf("start is day %d with %d rows, want day 6 with 10", start.Day, len(start.Grid))
+	}
+
+	var done Done
+	if err := json.Unmarshal([]byte(events[len(events)-1].data), &done); err != nil {
+		t.Fatal(err)
+	}
+	if done.Steps != len(events)-2 || done.PartOne != "41" {
+		t.Errorf("done = %+v after %d steps, want part one 41", done, len(events)-2)
+	}
+}
+
+// TestEventsTimeout streams a run that times out while the solver is still
+// tracing, which must end the stream with a single error event. Run with
+// -race to catch steps written alongside it.
+func TestEventsTimeout(t *testing.T) {
+	root := t.TempDir()
+	in, err := gen.New(6, 1, 400)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(root, "day-06"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, solver.InputPath(6, false)), in.Data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer((&Server{Root: root, Timeout: 50 * time.Millisecond}).Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/days/6/events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	events := readEvents(t, resp.Body)
+	if len(events) < 3 {
+		t.Fatalf("got %d events, want the start and steps before the error", len(events))
+	}
+	last := events[len(events)-1]
+	if last.name != "error" || !strings.Contains(last.data, "timed out") {
+		t.Fatalf("last event = %s %s, want a timeout error", last.name, last.data)
+	}
+	for _, e := range events[:len(events)-1] {
+		if e.name == "error" || e.name == "done" || !json.Valid([]byte(e.data)) {
+			t.Fatalf("event %s %q before the end", e.name, e.data)
+		}
+	}
+}
+
+func TestEventsErrors(t *testing.T) {
+	srv := newServer(t)
+	for _, tt := range []struct {
+		path string
+		code int
+	}{
+		{"/days/x/events", http.StatusBadRequest},
+		{"/days/99/events", http.StatusNotFound},
+		{"/days/1/events", http.StatusBadRequest},
+		{"/days/6/events?delay=soon", http.StatusBadRequest},
+	} {
+		resp, err := http.Get(srv.URL + tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.code {
+			t.Errorf("GET %s = %d, want %d", tt.path, resp.StatusCode, tt.code)
+		}
+	}
+}